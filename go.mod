@@ -1,5 +1,10 @@
 module github.com/Miro-Ecosystem/go-miro
 
-go 1.16
+go 1.18
 
-require github.com/google/go-cmp v0.5.2
+require (
+	github.com/golang/mock v1.6.0
+	github.com/google/go-cmp v0.5.2
+)
+
+require golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
@@ -0,0 +1,220 @@
+// Package accessreview generates organization-wide access review reports of Miro boards.
+package accessreview
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Member represents a member of a board.
+type Member struct {
+	UserID   string         `json:"userId"`
+	Name     string         `json:"name"`
+	Email    string         `json:"email"`
+	Role     miro.BoardRole `json:"role"`
+	External bool           `json:"external"`
+
+	// TeamRole is the role of the member in the team of the board, empty when not a member of the team.
+	TeamRole miro.TeamRole `json:"teamRole,omitempty"`
+
+	// Unresolved reports that the user of the member could not be fetched, for example a deleted user.
+	// The member only has the ID and the name of its board connection. Its email being unknown,
+	// it is reported as external.
+	Unresolved bool `json:"unresolved,omitempty"`
+}
+
+// Label returns the email of the member, or its name, or ID, when the email is unknown.
+func (m *Member) Label() string {
+	switch {
+	case m.Email != "":
+		return m.Email
+	case m.Name != "":
+		return m.Name
+	default:
+		return m.UserID
+	}
+}
+
+// Violation represents a rule that a board does not comply with.
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// BoardReport represents the access review of a single board.
+type BoardReport struct {
+	TeamID          string           `json:"teamId"`
+	BoardID         string           `json:"boardId"`
+	BoardName       string           `json:"boardName"`
+	ViewLink        string           `json:"viewLink"`
+	Access          miro.AccessLevel `json:"access"`
+	TeamAccess      miro.AccessLevel `json:"teamAccess"`
+	Members         []*Member        `json:"members"`
+	ExternalMembers []*Member        `json:"externalMembers"`
+	Violations      []*Violation     `json:"violations"`
+}
+
+// Report represents the access review of every board.
+type Report struct {
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Boards      []*BoardReport `json:"boards"`
+}
+
+// Violations returns the number of violations in the report.
+func (r *Report) Violations() int {
+	n := 0
+	for _, b := range r.Boards {
+		n += len(b.Violations)
+	}
+
+	return n
+}
+
+// Generator generates access review reports.
+type Generator struct {
+	client *miro.Client
+
+	// OrgDomains is the list of email domains of the organization.
+	// Members with email in other domains are reported as external.
+	OrgDomains []string
+
+	// Rules is the list of rules to check each board against.
+	Rules []Rule
+}
+
+// NewGenerator returns a new report generator.
+func NewGenerator(client *miro.Client, orgDomains []string, rules ...Rule) *Generator {
+	return &Generator{
+		client:     client,
+		OrgDomains: orgDomains,
+		Rules:      rules,
+	}
+}
+
+// Generate generates the access review report of every board in the teams.
+func (g *Generator) Generate(ctx context.Context, teamIDs ...string) (*Report, error) {
+	report := &Report{
+		GeneratedAt: time.Now(),
+		Boards:      []*BoardReport{},
+	}
+	users := map[string]*miro.User{}
+
+	for _, teamID := range teamIDs {
+		members, _, err := g.client.Teams.ListAllTeamMembers(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		team := map[string]miro.TeamRole{}
+		for _, m := range members {
+			if m.User != nil {
+				team[m.User.ID] = m.Role
+			}
+		}
+
+		boards, _, err := g.client.Boards.ListAllTeamBoards(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, board := range boards {
+			b, err := g.reviewBoard(ctx, teamID, board, team, users)
+			if err != nil {
+				return nil, err
+			}
+
+			report.Boards = append(report.Boards, b)
+		}
+	}
+
+	return report, nil
+}
+
+// reviewBoard reviews the board of the team, whose members are in team by User ID.
+// The users fetched are cached in users, nil for the users that could not be fetched.
+func (g *Generator) reviewBoard(ctx context.Context, teamID string, board *miro.Board,
+	team map[string]miro.TeamRole, users map[string]*miro.User) (*BoardReport, error) {
+	b := &BoardReport{
+		TeamID:          teamID,
+		BoardID:         board.ID,
+		BoardName:       board.Name,
+		ViewLink:        board.ViewLink,
+		Members:         []*Member{},
+		ExternalMembers: []*Member{},
+		Violations:      []*Violation{},
+	}
+
+	if board.SharingPolicy != nil {
+		b.Access = board.SharingPolicy.Access
+		b.TeamAccess = board.SharingPolicy.TeamAccess
+	}
+
+	conns, _, err := g.client.BoardUserConnection.ListAllMembers(ctx, board.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conn := range conns {
+		if conn.User == nil {
+			continue
+		}
+
+		user, ok := users[conn.User.ID]
+		if !ok {
+			// A user that cannot be fetched, for example a deleted one, does not stop the review.
+			user, _, err = g.client.Users.Get(ctx, conn.User.ID)
+			if err != nil && ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			users[conn.User.ID] = user
+		}
+
+		m := &Member{
+			UserID:     conn.User.ID,
+			Name:       conn.User.Name,
+			Role:       conn.Role,
+			TeamRole:   team[conn.User.ID],
+			Unresolved: user == nil,
+		}
+		if user != nil {
+			m.Name = user.Name
+			m.Email = user.Email
+		}
+		m.External = !g.isOrgEmail(m.Email)
+
+		b.Members = append(b.Members, m)
+		if m.External {
+			b.ExternalMembers = append(b.ExternalMembers, m)
+		}
+	}
+
+	for _, rule := range g.Rules {
+		for _, msg := range rule.Check(b) {
+			b.Violations = append(b.Violations, &Violation{
+				Rule:    rule.Name(),
+				Message: msg,
+			})
+		}
+	}
+
+	return b, nil
+}
+
+func (g *Generator) isOrgEmail(email string) bool {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(email[i+1:])
+	for _, d := range g.OrgDomains {
+		if domain == strings.ToLower(d) {
+			return true
+		}
+	}
+
+	return false
+}
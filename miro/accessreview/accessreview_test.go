@@ -0,0 +1,153 @@
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func handleTeam(mux *http.ServeMux) {
+	mux.HandleFunc("/teams/team/user-connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"limit": 10,
+	"offset": 0,
+	"size": 1,
+	"data": [
+		{"id": "t1", "role": "admin", "user": {"id": "alice", "name": "Alice"}}
+	]
+}`)
+	})
+
+	mux.HandleFunc("/teams/team/boards", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"limit": 10,
+	"offset": 0,
+	"size": 2,
+	"data": [
+		{"id": "public", "name": "Public", "sharingPolicy": {"access": "edit", "teamAccess": "edit"}},
+		{"id": "private", "name": "Private", "sharingPolicy": {"access": "private", "teamAccess": "edit"}}
+	]
+}`)
+	})
+
+	mux.HandleFunc("/boards/public/user-connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"size": 3,
+	"data": [
+		{"id": "c1", "role": "owner", "user": {"id": "alice", "name": "Alice"}},
+		{"id": "c2", "role": "editor", "user": {"id": "bob", "name": "Bob"}},
+		{"id": "c4", "role": "viewer", "user": {"id": "carol", "name": "Carol"}}
+	]
+}`)
+	})
+
+	mux.HandleFunc("/boards/private/user-connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"size": 1,
+	"data": [
+		{"id": "c3", "role": "owner", "user": {"id": "alice", "name": "Alice"}}
+	]
+}`)
+	})
+
+	mux.HandleFunc("/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "alice", "name": "Alice", "email": "alice@example.com"}`)
+	})
+
+	mux.HandleFunc("/users/bob", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "bob", "name": "Bob", "email": "bob@partner.com"}`)
+	})
+
+	// carol is deleted, the user is not found.
+	mux.HandleFunc("/users/carol", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": 404, "message": "not found"}`)
+	})
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+	handleTeam(mux)
+
+	alice := &Member{UserID: "alice", Name: "Alice", Email: "alice@example.com", Role: miro.BoardRoleOwner, TeamRole: miro.TeamRoleAdmin}
+	bob := &Member{UserID: "bob", Name: "Bob", Email: "bob@partner.com", Role: miro.BoardRoleEditor, External: true}
+	carol := &Member{UserID: "carol", Name: "Carol", Role: miro.BoardRoleViewer, External: true, Unresolved: true}
+
+	tcs := map[string]struct {
+		rules []Rule
+		want  []*BoardReport
+	}{
+		"no rules": {nil, []*BoardReport{
+			{
+				TeamID:          "team",
+				BoardID:         "public",
+				BoardName:       "Public",
+				Access:          "edit",
+				TeamAccess:      "edit",
+				Members:         []*Member{alice, bob, carol},
+				ExternalMembers: []*Member{bob, carol},
+				Violations:      []*Violation{},
+			},
+			{
+				TeamID:          "team",
+				BoardID:         "private",
+				BoardName:       "Private",
+				Access:          "private",
+				TeamAccess:      "edit",
+				Members:         []*Member{alice},
+				ExternalMembers: []*Member{},
+				Violations:      []*Violation{},
+			},
+		}},
+		"rules": {[]Rule{
+			&PublicAccessRule{Allowed: []miro.AccessLevel{miro.AccessLevelPrivate, miro.AccessLevelView}},
+			&ExternalMembersRule{Max: 0},
+			&ExternalMembersRule{Max: -1, AllowedRoles: []miro.BoardRole{miro.BoardRoleViewer}},
+		}, []*BoardReport{
+			{
+				TeamID:          "team",
+				BoardID:         "public",
+				BoardName:       "Public",
+				Access:          "edit",
+				TeamAccess:      "edit",
+				Members:         []*Member{alice, bob, carol},
+				ExternalMembers: []*Member{bob, carol},
+				Violations: []*Violation{
+					{Rule: "public-access", Message: `public link access "edit" is not allowed`},
+					{Rule: "external-members", Message: "2 external members exceed the maximum of 0"},
+					{Rule: "external-members", Message: `external member bob@partner.com has role "editor"`},
+				},
+			},
+			{
+				TeamID:          "team",
+				BoardID:         "private",
+				BoardName:       "Private",
+				Access:          "private",
+				TeamAccess:      "edit",
+				Members:         []*Member{alice},
+				ExternalMembers: []*Member{},
+				Violations:      []*Violation{},
+			},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			g := NewGenerator(client, []string{"example.com"}, tc.rules...)
+			got, err := g.Generate(context.Background(), "team")
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got.Boards, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
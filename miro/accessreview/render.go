@@ -0,0 +1,107 @@
+package accessreview
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+var csvHeader = []string{
+	"team_id",
+	"board_id",
+	"board_name",
+	"view_link",
+	"access",
+	"team_access",
+	"members",
+	"external_members",
+	"violations",
+}
+
+// WriteCSV writes the report as CSV with a row for each board.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, b := range r.Boards {
+		external := make([]string, len(b.ExternalMembers))
+		for i, m := range b.ExternalMembers {
+			external[i] = fmt.Sprintf("%s (%s)", m.Label(), m.Role)
+		}
+
+		violations := make([]string, len(b.Violations))
+		for i, v := range b.Violations {
+			violations[i] = fmt.Sprintf("%s: %s", v.Rule, v.Message)
+		}
+
+		err := cw.Write([]string{
+			b.TeamID,
+			b.BoardID,
+			b.BoardName,
+			b.ViewLink,
+			string(b.Access),
+			string(b.TeamAccess),
+			fmt.Sprint(len(b.Members)),
+			strings.Join(external, "; "),
+			strings.Join(violations, "; "),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t time.Time) string { return t.Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Miro access review</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+tr.violation { background: #fdecea; }
+</style>
+</head>
+<body>
+<h1>Miro access review</h1>
+<p>Generated at {{ date .GeneratedAt }}, {{ len .Boards }} boards, {{ .Violations }} violations.</p>
+<table>
+<tr><th>Team</th><th>Board</th><th>Access</th><th>Team access</th><th>Members</th><th>External members</th><th>Violations</th></tr>
+{{- range .Boards }}
+<tr{{ if .Violations }} class="violation"{{ end }}>
+<td>{{ .TeamID }}</td>
+<td>{{ if .ViewLink }}<a href="{{ .ViewLink }}">{{ .BoardName }}</a>{{ else }}{{ .BoardName }}{{ end }}</td>
+<td>{{ .Access }}</td>
+<td>{{ .TeamAccess }}</td>
+<td>{{ len .Members }}</td>
+<td><ul>{{ range .ExternalMembers }}<li>{{ .Label }} ({{ .Role }})</li>{{ end }}</ul></td>
+<td><ul>{{ range .Violations }}<li>{{ .Rule }}: {{ .Message }}</li>{{ end }}</ul></td>
+</tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the report as a HTML page.
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
@@ -0,0 +1,82 @@
+package accessreview
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func getReport() *Report {
+	bob := &Member{UserID: "bob", Name: "Bob", Email: "bob@partner.com", Role: miro.BoardRoleEditor, External: true}
+
+	return &Report{
+		GeneratedAt: time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC),
+		Boards: []*BoardReport{
+			{
+				TeamID:          "team",
+				BoardID:         "public",
+				BoardName:       "Public <board>",
+				Access:          "edit",
+				TeamAccess:      "edit",
+				Members:         []*Member{bob},
+				ExternalMembers: []*Member{bob},
+				Violations: []*Violation{
+					{Rule: "public-access", Message: `public link access "edit" is not allowed`},
+				},
+			},
+		},
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := getReport().WriteJSON(buf); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got := &Report{}
+	if err := json.Unmarshal(buf.Bytes(), got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, getReport()); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := getReport().WriteCSV(buf); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `team_id,board_id,board_name,view_link,access,team_access,members,external_members,violations
+team,public,Public <board>,,edit,edit,1,bob@partner.com (editor),"public-access: public link access ""edit"" is not allowed"
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestReport_WriteHTML(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := getReport().WriteHTML(buf); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"Public &lt;board&gt;",
+		"<li>bob@partner.com (editor)</li>",
+		`<tr class="violation">`,
+		"1 boards, 1 violations",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("%q not found in:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package accessreview
+
+import (
+	"fmt"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Rule checks a board against a policy.
+type Rule interface {
+	// Name returns the name of the rule reported with its violations.
+	Name() string
+
+	// Check returns a message for each violation of the rule by the board.
+	Check(b *BoardReport) []string
+}
+
+// PublicAccessRule restricts the access level of the public link of boards.
+type PublicAccessRule struct {
+	Allowed []miro.AccessLevel
+}
+
+// Name returns the name of the rule.
+func (r *PublicAccessRule) Name() string {
+	return "public-access"
+}
+
+// Check checks the public link access level of the board.
+func (r *PublicAccessRule) Check(b *BoardReport) []string {
+	if b.Access == "" || containsAccess(r.Allowed, b.Access) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("public link access %q is not allowed", b.Access)}
+}
+
+// TeamAccessRule restricts the access level of boards for the team.
+type TeamAccessRule struct {
+	Allowed []miro.AccessLevel
+}
+
+// Name returns the name of the rule.
+func (r *TeamAccessRule) Name() string {
+	return "team-access"
+}
+
+// Check checks the team access level of the board.
+func (r *TeamAccessRule) Check(b *BoardReport) []string {
+	if b.TeamAccess == "" || containsAccess(r.Allowed, b.TeamAccess) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("team access %q is not allowed", b.TeamAccess)}
+}
+
+// ExternalMembersRule restricts the external members of boards.
+type ExternalMembersRule struct {
+	// Max is the maximum number of external members. Negative means no limit.
+	Max int
+
+	// AllowedRoles is the list of roles that external members may have.
+	// Empty means every role is allowed.
+	AllowedRoles []miro.BoardRole
+}
+
+// Name returns the name of the rule.
+func (r *ExternalMembersRule) Name() string {
+	return "external-members"
+}
+
+// Check checks the external members of the board.
+func (r *ExternalMembersRule) Check(b *BoardReport) []string {
+	msgs := []string{}
+	if r.Max >= 0 && len(b.ExternalMembers) > r.Max {
+		msgs = append(msgs, fmt.Sprintf("%d external members exceed the maximum of %d", len(b.ExternalMembers), r.Max))
+	}
+
+	if len(r.AllowedRoles) == 0 {
+		return msgs
+	}
+
+	for _, m := range b.ExternalMembers {
+		allowed := false
+		for _, role := range r.AllowedRoles {
+			if m.Role == role {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			msgs = append(msgs, fmt.Sprintf("external member %s has role %q", m.Label(), m.Role))
+		}
+	}
+
+	return msgs
+}
+
+func containsAccess(levels []miro.AccessLevel, level miro.AccessLevel) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,209 @@
+package miro
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/mock.go -package=mock
+
+// AuditLogsAPI is the interface implemented by AuditLogsService.
+type AuditLogsAPI interface {
+	Get(ctx context.Context) (*AuditLog, *Response, error)
+}
+
+// AuthzInfoAPI is the interface implemented by AuthzInfoService.
+type AuthzInfoAPI interface {
+	Get(ctx context.Context) (*AuthorizationInfo, *Response, error)
+}
+
+// BoardsAPI is the interface implemented by BoardsService.
+type BoardsAPI interface {
+	Get(ctx context.Context, id string) (*Board, *Response, error)
+	Create(ctx context.Context, b *CreateBoardRequest) (*Board, *Response, error)
+	Share(ctx context.Context, id string, request *ShareBoardRequest) (*ListBoardsResponse, *Response, error)
+	ShareWithRoles(ctx context.Context, id string, request *ShareBoardWithRolesRequest) ([]*BoardUserConnection, *Response, error)
+	Update(ctx context.Context, id string, b *UpdateBoardRequest) (*Board, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+	GetCurrentUserBoards(ctx context.Context, teamID string) (*ListBoardsResponse, *Response, error)
+	ListTeamBoards(ctx context.Context, teamID string, opt *ListOptions) (*ListBoardsResponse, *Response, error)
+	ListAllTeamBoards(ctx context.Context, teamID string) ([]*Board, *Response, error)
+}
+
+// BoardUserConnectionAPI is the interface implemented by BoardUserConnectionService.
+type BoardUserConnectionAPI interface {
+	Get(ctx context.Context, id string) (*BoardUserConnection, *Response, error)
+	ListMembers(ctx context.Context, boardID string, opt *ListOptions) (*ListBoardUserConnectionsResponse, *Response, error)
+	ListAllMembers(ctx context.Context, boardID string) ([]*BoardUserConnection, *Response, error)
+	TransferOwnership(ctx context.Context, boardID, userID string) (*BoardUserConnection, *Response, error)
+	Updates(ctx context.Context, id string, request *UpdateBoardUserConnectionRequest) (*BoardUserConnection, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// CommentsAPI is the interface implemented by CommentsService.
+type CommentsAPI interface {
+	List(ctx context.Context, boardID string) ([]*CommentThread, *Response, error)
+	ListByWidget(ctx context.Context, boardID, widgetID string) ([]*CommentThread, *Response, error)
+	Get(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error)
+	Reply(ctx context.Context, boardID, threadID, text string) (*Comment, *Response, error)
+	Resolve(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error)
+	Reopen(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error)
+}
+
+// OrganizationsAPI is the interface implemented by OrganizationsService.
+type OrganizationsAPI interface {
+	Get(ctx context.Context, id string) (*Organization, *Response, error)
+	ListMembers(ctx context.Context, id string, opt *ListOrganizationMembersOptions) (*ListOrganizationMembersResponse, *Response, error)
+	ListAllMembers(ctx context.Context, id string, opt *ListOrganizationMembersOptions) ([]*OrganizationMember, *Response, error)
+	GetMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error)
+	DeactivateMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error)
+	ReactivateMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error)
+	ListTeams(ctx context.Context, id string, opt *CursorOptions) (*ListTeamsResponse, *Response, error)
+	ListAllTeams(ctx context.Context, id string) ([]*Team, *Response, error)
+}
+
+// PicturesAPI is the interface implemented by PicturesService.
+type PicturesAPI interface {
+	Get(ctx context.Context, id string) (*Picture, *Response, error)
+	Upsert(ctx context.Context, id string, request *UpsertPictureRequest) (*Picture, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// TagsAPI is the interface implemented by TagsService.
+type TagsAPI interface {
+	List(ctx context.Context, boardID string) ([]*Tag, *Response, error)
+	Get(ctx context.Context, boardID, tagID string) (*Tag, *Response, error)
+	Create(ctx context.Context, boardID string, request *TagRequest) (*Tag, *Response, error)
+	Update(ctx context.Context, boardID, tagID string, request *TagRequest) (*Tag, *Response, error)
+	Delete(ctx context.Context, boardID, tagID string) (*Response, error)
+	Attach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*Tag, *Response, error)
+	Detach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*Tag, *Response, error)
+}
+
+// TeamsAPI is the interface implemented by TeamsService.
+type TeamsAPI interface {
+	Get(ctx context.Context, id string) (*Team, *Response, error)
+	Update(ctx context.Context, id string, request *UpdateTeamRequest) (*Team, *Response, error)
+	ListTeamMembers(ctx context.Context, id string, opt *ListOptions) (*ListTeamMembersResponse, *Response, error)
+	ListAllTeamMembers(ctx context.Context, id string) ([]*TeamUserConnection, *Response, error)
+	GetCurrentUserConnection(ctx context.Context, id string) (*TeamUserConnection, *Response, error)
+	Invite(ctx context.Context, id string, email string) ([]*TeamUserConnection, *Response, error)
+	InviteMany(ctx context.Context, id string, request *InviteManyRequest) ([]*TeamUserConnection, *Response, error)
+	InviteWithRoles(ctx context.Context, id string, request *InviteWithRolesRequest) ([]*TeamUserConnection, *Response, error)
+	ListByOrganization(ctx context.Context, orgID string, opt *CursorOptions) (*ListTeamsResponse, *Response, error)
+	ListAllByOrganization(ctx context.Context, orgID string) ([]*Team, *Response, error)
+	Create(ctx context.Context, orgID string, request *CreateTeamRequest) (*Team, *Response, error)
+	Delete(ctx context.Context, orgID, id string) (*Response, error)
+	GetSettings(ctx context.Context, orgID, id string) (*TeamSettings, *Response, error)
+	UpdateSettings(ctx context.Context, orgID, id string, request *TeamSettings) (*TeamSettings, *Response, error)
+}
+
+// TeamUserConnectionAPI is the interface implemented by TeamUserConnectionService.
+type TeamUserConnectionAPI interface {
+	Get(ctx context.Context, id string) (*TeamUserConnection, *Response, error)
+	Update(ctx context.Context, id string, request *UpdateTeamUserConnectionRequest) (*TeamUserConnection, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// UsersAPI is the interface implemented by UsersService.
+type UsersAPI interface {
+	Get(ctx context.Context, id string) (*User, *Response, error)
+	GetCurrentUser(ctx context.Context) (*User, *Response, error)
+	UpdateCurrentUser(ctx context.Context, request *UpdateCurrentUserRequest) (*User, *Response, error)
+}
+
+// WidgetsAPI is the interface implemented by WidgetsService.
+type WidgetsAPI interface {
+	List(ctx context.Context, boardID string, widgetType WidgetType) ([]Widget, *Response, error)
+	ListByTag(ctx context.Context, boardID, tagID string, widgetType WidgetType) ([]Widget, *Response, error)
+	Get(ctx context.Context, boardID, widgetID string) (Widget, *Response, error)
+	Create(ctx context.Context, boardID string, w Widget) (Widget, *Response, error)
+	Update(ctx context.Context, boardID, widgetID string, w Widget) (Widget, *Response, error)
+	Move(ctx context.Context, boardID, widgetID string, p Point) (Widget, *Response, error)
+	MoveMany(ctx context.Context, boardID string, positions map[string]Point) ([]Widget, *Response, error)
+	CreateMany(ctx context.Context, boardID string, widgets []Widget, opt *BulkOptions) (BulkResults, error)
+	UpdateMany(ctx context.Context, boardID string, widgets []Widget, opt *BulkOptions) (BulkResults, error)
+	DeleteMany(ctx context.Context, boardID string, widgetIDs []string, opt *BulkOptions) (BulkResults, error)
+	Delete(ctx context.Context, boardID, widgetID string) (*Response, error)
+	GetFrame(ctx context.Context, boardID, frameID string) (*Frame, *Response, error)
+	ListFrameChildren(ctx context.Context, boardID, frameID string) ([]Widget, *Response, error)
+	MoveIntoFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*Frame, *Response, error)
+	MoveOutOfFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*Frame, *Response, error)
+	PlaceInFrame(ctx context.Context, boardID, frameID, widgetID string, p Point) (*Frame, *Response, error)
+	FrameTree(ctx context.Context, boardID string) (*FrameNode, *Response, error)
+	Connect(ctx context.Context, boardID, fromWidgetID, toWidgetID string, style *LineStyle) (*Line, *Response, error)
+	LinesAttachedTo(ctx context.Context, boardID, widgetID string) ([]*Line, *Response, error)
+}
+
+// BoardsV2API is the interface implemented by BoardsV2Service.
+type BoardsV2API interface {
+	List(ctx context.Context, opt *ListBoardsV2Options) (*ListBoardsV2Response, *Response, error)
+	ListAll(ctx context.Context, opt *ListBoardsV2Options) ([]*BoardV2, *Response, error)
+	Get(ctx context.Context, id string) (*BoardV2, *Response, error)
+	Create(ctx context.Context, body *CreateBoardV2Request) (*BoardV2, *Response, error)
+	Copy(ctx context.Context, id string, body *CreateBoardV2Request) (*BoardV2, *Response, error)
+	Update(ctx context.Context, id string, body *CreateBoardV2Request) (*BoardV2, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// ItemsV2API is the interface implemented by ItemsV2Service.
+type ItemsV2API interface {
+	List(ctx context.Context, boardID string, opt *ListItemsV2Options) (*ListItemsV2Response, *Response, error)
+	ListAll(ctx context.Context, boardID string, opt *ListItemsV2Options) ([]*ItemV2, *Response, error)
+	Get(ctx context.Context, boardID, itemID string) (*ItemV2, *Response, error)
+	Create(ctx context.Context, boardID string, itemType ItemTypeV2, body *ItemV2Request) (*ItemV2, *Response, error)
+	Update(ctx context.Context, boardID string, itemType ItemTypeV2, itemID string, body *ItemV2Request) (*ItemV2, *Response, error)
+	Delete(ctx context.Context, boardID, itemID string) (*Response, error)
+}
+
+// ConnectorsV2API is the interface implemented by ConnectorsV2Service.
+type ConnectorsV2API interface {
+	List(ctx context.Context, boardID string, opt *CursorOptions) (*ListConnectorsV2Response, *Response, error)
+	ListAll(ctx context.Context, boardID string) ([]*ConnectorV2, *Response, error)
+	Get(ctx context.Context, boardID, connectorID string) (*ConnectorV2, *Response, error)
+	Create(ctx context.Context, boardID string, body *ConnectorV2Request) (*ConnectorV2, *Response, error)
+	Update(ctx context.Context, boardID, connectorID string, body *ConnectorV2Request) (*ConnectorV2, *Response, error)
+	Delete(ctx context.Context, boardID, connectorID string) (*Response, error)
+}
+
+// TagsV2API is the interface implemented by TagsV2Service.
+type TagsV2API interface {
+	List(ctx context.Context, boardID string, opt *ListOptions) (*ListTagsV2Response, *Response, error)
+	ListAll(ctx context.Context, boardID string) ([]*TagV2, *Response, error)
+	Get(ctx context.Context, boardID, tagID string) (*TagV2, *Response, error)
+	Create(ctx context.Context, boardID string, body *TagV2Request) (*TagV2, *Response, error)
+	Update(ctx context.Context, boardID, tagID string, body *TagV2Request) (*TagV2, *Response, error)
+	Delete(ctx context.Context, boardID, tagID string) (*Response, error)
+	Attach(ctx context.Context, boardID, itemID, tagID string) (*Response, error)
+	Detach(ctx context.Context, boardID, itemID, tagID string) (*Response, error)
+	ListItemTags(ctx context.Context, boardID, itemID string) ([]*TagV2, *Response, error)
+}
+
+// BoardMembersV2API is the interface implemented by BoardMembersV2Service.
+type BoardMembersV2API interface {
+	List(ctx context.Context, boardID string, opt *ListOptions) (*ListBoardMembersV2Response, *Response, error)
+	ListAll(ctx context.Context, boardID string) ([]*BoardMemberV2, *Response, error)
+	Get(ctx context.Context, boardID, memberID string) (*BoardMemberV2, *Response, error)
+	Share(ctx context.Context, boardID string, body *ShareBoardV2Request) (*ShareBoardV2Response, *Response, error)
+	Update(ctx context.Context, boardID, memberID string, role BoardRole) (*BoardMemberV2, *Response, error)
+	Delete(ctx context.Context, boardID, memberID string) (*Response, error)
+}
+
+var (
+	_ AuditLogsAPI           = (*AuditLogsService)(nil)
+	_ AuthzInfoAPI           = (*AuthzInfoService)(nil)
+	_ BoardsAPI              = (*BoardsService)(nil)
+	_ BoardUserConnectionAPI = (*BoardUserConnectionService)(nil)
+	_ CommentsAPI            = (*CommentsService)(nil)
+	_ OrganizationsAPI       = (*OrganizationsService)(nil)
+	_ PicturesAPI            = (*PicturesService)(nil)
+	_ TagsAPI                = (*TagsService)(nil)
+	_ TeamsAPI               = (*TeamsService)(nil)
+	_ TeamUserConnectionAPI  = (*TeamUserConnectionService)(nil)
+	_ UsersAPI               = (*UsersService)(nil)
+	_ WidgetsAPI             = (*WidgetsService)(nil)
+	_ BoardsV2API            = (*BoardsV2Service)(nil)
+	_ ItemsV2API             = (*ItemsV2Service)(nil)
+	_ ConnectorsV2API        = (*ConnectorsV2Service)(nil)
+	_ TagsV2API              = (*TagsV2Service)(nil)
+	_ BoardMembersV2API      = (*BoardMembersV2Service)(nil)
+)
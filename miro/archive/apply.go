@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// ApplyOptions specifies the optional parameters of Apply.
+type ApplyOptions struct {
+	// Emails maps the User IDs of the members added to their emails, to share the board with them.
+	// Snapshots hold no emails, so added members missing from it are skipped.
+	Emails map[string]string
+
+	// Bulk specifies the bulk operations on the widgets.
+	Bulk *miro.BulkOptions
+}
+
+// ApplyResult represents the changes applied to the board.
+type ApplyResult struct {
+	Created miro.BulkResults
+	Updated miro.BulkResults
+	Deleted miro.BulkResults
+
+	// Skipped are the User IDs of the members added without email.
+	Skipped []string
+}
+
+// Apply applies the diff to the board, which must be in the state of the base snapshot of the diff.
+// To apply a merge, compare a snapshot of the board fetched with export.Fetch to the merged snapshot.
+//
+// Widgets matched by content are updated in place, keeping the IDs of the board. The metadata and
+// the members are applied first, then the widgets with bulk operations, whose results are returned
+// even when the error is *miro.BulkError.
+func Apply(ctx context.Context, client *miro.Client, boardID string, d *Diff, opt *ApplyOptions) (*ApplyResult, error) {
+	if opt == nil {
+		opt = &ApplyOptions{}
+	}
+
+	res := &ApplyResult{}
+
+	if d.Board != nil && d.Board.After != nil {
+		b := d.Board.After
+		_, _, err := client.Boards.Update(ctx, boardID, &miro.UpdateBoardRequest{
+			Name:          b.Name,
+			Description:   b.Description,
+			SharingPolicy: b.SharingPolicy,
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if err := applyMembers(ctx, client, boardID, d.Members, opt, res); err != nil {
+		return res, err
+	}
+
+	var create, update []miro.Widget
+	var remove []string
+	for _, c := range d.Widgets {
+		switch c.Op {
+		case OpAdded:
+			create = append(create, c.After)
+		case OpRemoved:
+			remove = append(remove, c.ID)
+		case OpChanged:
+			w, err := withID(c.After, c.ID)
+			if err != nil {
+				return res, err
+			}
+			update = append(update, w)
+		}
+	}
+
+	var err error
+	if len(remove) > 0 {
+		if res.Deleted, err = client.Widgets.DeleteMany(ctx, boardID, remove, opt.Bulk); err != nil {
+			return res, err
+		}
+	}
+
+	if len(update) > 0 {
+		if res.Updated, err = client.Widgets.UpdateMany(ctx, boardID, update, opt.Bulk); err != nil {
+			return res, err
+		}
+	}
+
+	if len(create) > 0 {
+		if res.Created, err = client.Widgets.CreateMany(ctx, boardID, create, opt.Bulk); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+func applyMembers(ctx context.Context, client *miro.Client, boardID string, changes []*MemberChange, opt *ApplyOptions, res *ApplyResult) error {
+	var invitations []*miro.BoardInvitation
+	for _, c := range changes {
+		switch c.Op {
+		case OpAdded:
+			email, ok := opt.Emails[c.UserID]
+			if !ok {
+				res.Skipped = append(res.Skipped, c.UserID)
+				continue
+			}
+			invitations = append(invitations, &miro.BoardInvitation{Email: email, Role: c.After.Role})
+		case OpRemoved:
+			if _, err := client.BoardUserConnection.Delete(ctx, c.Before.ID); err != nil {
+				return err
+			}
+		case OpChanged:
+			req := &miro.UpdateBoardUserConnectionRequest{Role: c.After.Role}
+			if _, _, err := client.BoardUserConnection.Updates(ctx, c.Before.ID, req); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(invitations) > 0 {
+		req := &miro.ShareBoardWithRolesRequest{Invitations: invitations}
+		if _, _, err := client.Boards.ShareWithRoles(ctx, boardID, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withID returns a copy of the widget with the ID, to update a widget matched by content in place.
+func withID(w miro.Widget, id string) (miro.Widget, error) {
+	if w.Base().ID == id {
+		return w, nil
+	}
+
+	m, err := rawMembers(w)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := decodeWidget(m)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Base().ID = id
+	return c, nil
+}
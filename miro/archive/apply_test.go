@@ -0,0 +1,97 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApply(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var got []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		call := r.Method + " " + r.URL.Path
+		for _, k := range []string{"name", "role", "emails", "text", "x"} {
+			if v, ok := body[k]; ok {
+				call += fmt.Sprintf(" %s=%v", k, v)
+			}
+		}
+		got = append(got, call)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/boards/board/share":
+			fmt.Fprint(w, `{"data": []}`)
+		case r.Method == http.MethodPost:
+			body["id"] = "new"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(body)
+		default:
+			json.NewEncoder(w).Encode(body)
+		}
+	})
+
+	head := snapshot()
+	head.Board.Name = "Roadmap 2022"
+	head.Members = []*miro.BoardUserConnection{
+		{ID: "c2", User: &miro.MiniUser{ID: "bob", Name: "Bob"}, Role: miro.BoardRoleEditor},
+		{User: &miro.MiniUser{ID: "carol"}, Role: miro.BoardRoleViewer},
+		{User: &miro.MiniUser{ID: "dave"}, Role: miro.BoardRoleViewer},
+	}
+	head.Widgets = []miro.Widget{
+		sticker("s1", 50, "<p>Search</p>"),
+		sticker("s9", 150, "<p>Billing</p>"),
+		sticker("s4", 300, "<p>Imports</p>"),
+		sticker("s5", 400, "<p>Reports</p>"),
+	}
+
+	d, err := Compare(snapshot(), head, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	res, err := Apply(context.Background(), client, "board", d, &ApplyOptions{Emails: map[string]string{"carol": "carol@example.com"}})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	sort.Strings(got)
+	want := []string{
+		"DELETE /board-user-connection/c1",
+		"DELETE /boards/board/widgets/s3",
+		"PATCH /board-user-connection/c2 role=editor",
+		"PATCH /boards/board name=Roadmap 2022",
+		"PATCH /boards/board/widgets/s1 text=<p>Search</p> x=50",
+		"PATCH /boards/board/widgets/s2 text=<p>Billing</p> x=150",
+		"POST /boards/board/share role=viewer emails=[carol@example.com]",
+		"POST /boards/board/widgets text=<p>Reports</p> x=400",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(res.Skipped, []string{"dave"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff([]int{len(res.Created), len(res.Updated), len(res.Deleted)}, []int{1, 2, 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,458 @@
+// Package archive compares, merges and applies the snapshots of Miro boards exported by package export.
+//
+// Compare computes the semantic diff of the metadata, the members and the widgets of two snapshots,
+// matching the widgets by ID, then the remaining ones by the similarity of their content, so that
+// widgets recreated with new IDs are reported as changed rather than removed and added. Text and JSON
+// render the diff for review, Merge merges two snapshots derived from a common one, and Apply applies
+// a diff to a board.
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/export"
+)
+
+const (
+	defaultSimilarity = 0.8
+)
+
+// Op represents the operation of a change.
+type Op string
+
+const (
+	OpAdded   Op = "added"
+	OpRemoved Op = "removed"
+	OpChanged Op = "changed"
+)
+
+// DiffOptions specifies the optional parameters of Compare and Merge.
+type DiffOptions struct {
+	// Similarity is the minimum share of equal members, from 0 to 1, matching two widgets of the same type
+	// with different IDs. 0.8 by default, a negative value matches the widgets by ID only.
+	Similarity float64
+}
+
+// FieldChange represents the change of a JSON member.
+// Before is empty when the member was added, After when it was removed.
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// BoardChange represents the changes of the metadata of the board.
+type BoardChange struct {
+	Fields []*FieldChange `json:"fields"`
+	Before *miro.Board    `json:"-"`
+	After  *miro.Board    `json:"-"`
+}
+
+// MemberChange represents a change of a board member, matched by User ID.
+type MemberChange struct {
+	Op     Op                        `json:"op"`
+	UserID string                    `json:"userId"`
+	Name   string                    `json:"name,omitempty"`
+	Fields []*FieldChange            `json:"fields,omitempty"`
+	Before *miro.BoardUserConnection `json:"-"`
+	After  *miro.BoardUserConnection `json:"-"`
+}
+
+// WidgetChange represents a change of a widget.
+// ID is the ID of the widget before, or after when added, and NewID the ID after when matched by content.
+type WidgetChange struct {
+	Op         Op              `json:"op"`
+	ID         string          `json:"id"`
+	NewID      string          `json:"newId,omitempty"`
+	Type       miro.WidgetType `json:"type"`
+	Similarity float64         `json:"similarity,omitempty"`
+	Fields     []*FieldChange  `json:"fields,omitempty"`
+	Before     miro.Widget     `json:"-"`
+	After      miro.Widget     `json:"-"`
+}
+
+// Diff represents the changes between two snapshots of a board.
+type Diff struct {
+	Board   *BoardChange    `json:"board,omitempty"`
+	Members []*MemberChange `json:"members,omitempty"`
+	Widgets []*WidgetChange `json:"widgets,omitempty"`
+}
+
+// Empty reports whether the snapshots are the same.
+func (d *Diff) Empty() bool {
+	return d.Board == nil && len(d.Members) == 0 && len(d.Widgets) == 0
+}
+
+var (
+	// ignoredBoardMembers are the members of boards changed by Miro itself.
+	ignoredBoardMembers = map[string]bool{
+		"id": true, "createdAt": true, "modifiedAt": true, "createdBy": true, "modifiedBy": true,
+		"currentUserConnection": true, "picture": true, "imageURL": true, "viewLink": true,
+	}
+
+	// ignoredMemberMembers are the members of board user connections other than the role.
+	ignoredMemberMembers = map[string]bool{
+		"id": true, "user": true, "createdAt": true, "modifiedAt": true, "createdBy": true, "modifiedBy": true,
+	}
+
+	// ignoredWidgetMembers are the members of widgets changed by Miro itself.
+	ignoredWidgetMembers = map[string]bool{
+		"id": true, "createdAt": true, "modifiedAt": true, "createdBy": true, "modifiedBy": true, "capabilities": true,
+	}
+)
+
+// Compare returns the changes from the base snapshot to the head snapshot.
+func Compare(base, head *export.Snapshot, opt *DiffOptions) (*Diff, error) {
+	d := &Diff{}
+
+	if base.Board != nil || head.Board != nil {
+		fields, err := diffValues(base.Board, head.Board, ignoredBoardMembers)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			d.Board = &BoardChange{Fields: fields, Before: base.Board, After: head.Board}
+		}
+	}
+
+	members, err := diffMembers(base.Members, head.Members)
+	if err != nil {
+		return nil, err
+	}
+	d.Members = members
+
+	widgets, err := diffWidgets(base.Widgets, head.Widgets, opt)
+	if err != nil {
+		return nil, err
+	}
+	d.Widgets = widgets
+
+	return d, nil
+}
+
+func diffMembers(base, head []*miro.BoardUserConnection) ([]*MemberChange, error) {
+	before := membersByUser(base)
+	after := membersByUser(head)
+
+	var changes []*MemberChange
+	for _, id := range unionKeys(before, after) {
+		b, a := before[id], after[id]
+		fields, err := diffValues(b, a, ignoredMemberMembers)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &MemberChange{Op: OpChanged, UserID: id, Fields: fields, Before: b, After: a}
+		switch {
+		case b == nil:
+			c.Op = OpAdded
+		case a == nil:
+			c.Op = OpRemoved
+		case len(fields) == 0:
+			continue
+		}
+
+		c.Name = memberName(a)
+		if c.Name == "" {
+			c.Name = memberName(b)
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+func membersByUser(conns []*miro.BoardUserConnection) map[string]*miro.BoardUserConnection {
+	m := make(map[string]*miro.BoardUserConnection, len(conns))
+	for _, c := range conns {
+		if c.User != nil {
+			m[c.User.ID] = c
+		}
+	}
+
+	return m
+}
+
+func memberName(c *miro.BoardUserConnection) string {
+	if c == nil || c.User == nil {
+		return ""
+	}
+
+	return c.User.Name
+}
+
+func diffWidgets(base, head []miro.Widget, opt *DiffOptions) ([]*WidgetChange, error) {
+	pairs, err := matchWidgets(base, head, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*WidgetChange
+	for _, p := range pairs {
+		fields, err := diffValues(p.before, p.after, ignoredWidgetMembers)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &WidgetChange{Op: OpChanged, Fields: fields, Before: p.before, After: p.after}
+		switch {
+		case p.before == nil:
+			c.Op = OpAdded
+			c.ID, c.Type = p.after.Base().ID, p.after.Base().Type
+		case p.after == nil:
+			c.Op = OpRemoved
+			c.ID, c.Type = p.before.Base().ID, p.before.Base().Type
+		default:
+			c.ID, c.Type = p.before.Base().ID, p.before.Base().Type
+			if id := p.after.Base().ID; id != c.ID {
+				c.NewID = id
+				c.Similarity = p.similarity
+			}
+			if len(fields) == 0 && c.NewID == "" {
+				continue
+			}
+		}
+
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+// pair represents a widget of the base snapshot and the widget of the other snapshot it matches.
+// Either is nil when the widget was added or removed.
+type pair struct {
+	before, after miro.Widget
+	similarity    float64
+}
+
+// matchWidgets pairs the widgets by ID, then the remaining ones of the same type by similarity, the most
+// similar first. The pairs are sorted by the ID of the widget before, or after when added.
+func matchWidgets(base, other []miro.Widget, opt *DiffOptions) ([]*pair, error) {
+	threshold := defaultSimilarity
+	if opt != nil && opt.Similarity != 0 {
+		threshold = opt.Similarity
+	}
+
+	byID := make(map[string]miro.Widget, len(other))
+	for _, w := range other {
+		byID[w.Base().ID] = w
+	}
+
+	var pairs []*pair
+	var removed []miro.Widget
+	matched := map[string]bool{}
+	for _, b := range base {
+		if a, ok := byID[b.Base().ID]; ok {
+			pairs = append(pairs, &pair{before: b, after: a, similarity: 1})
+			matched[b.Base().ID] = true
+		} else {
+			removed = append(removed, b)
+		}
+	}
+
+	var added []miro.Widget
+	for _, a := range other {
+		if !matched[a.Base().ID] {
+			added = append(added, a)
+		}
+	}
+
+	var candidates []*pair
+	if threshold >= 0 {
+		// The members of the added widgets are decoded once, not once per removed widget.
+		addedMembers := make([]map[string]json.RawMessage, len(added))
+		for i, a := range added {
+			af, err := members(a, ignoredWidgetMembers)
+			if err != nil {
+				return nil, err
+			}
+			addedMembers[i] = af
+		}
+
+		for _, b := range removed {
+			bf, err := members(b, ignoredWidgetMembers)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, a := range added {
+				if a.Base().Type != b.Base().Type {
+					continue
+				}
+
+				if s := similarity(bf, addedMembers[i]); s >= threshold {
+					candidates = append(candidates, &pair{before: b, after: a, similarity: s})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+
+	taken := map[miro.Widget]bool{}
+	for _, c := range candidates {
+		if !taken[c.before] && !taken[c.after] {
+			taken[c.before], taken[c.after] = true, true
+			pairs = append(pairs, c)
+		}
+	}
+
+	for _, b := range removed {
+		if !taken[b] {
+			pairs = append(pairs, &pair{before: b})
+		}
+	}
+
+	for _, a := range added {
+		if !taken[a] {
+			pairs = append(pairs, &pair{after: a})
+		}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].id() < pairs[j].id()
+	})
+
+	return pairs, nil
+}
+
+func (p *pair) id() string {
+	if p.before != nil {
+		return p.before.Base().ID
+	}
+
+	return p.after.Base().ID
+}
+
+// similarity returns the share of the members equal in both widgets.
+func similarity(a, b map[string]json.RawMessage) float64 {
+	keys := unionKeys(a, b)
+	if len(keys) == 0 {
+		return 1
+	}
+
+	equal := 0
+	for _, k := range keys {
+		av, aok := a[k]
+		bv, bok := b[k]
+		if aok && bok && string(av) == string(bv) {
+			equal++
+		}
+	}
+
+	return float64(equal) / float64(len(keys))
+}
+
+// diffValues returns the changes of the JSON members of two values, either nil, by member name.
+func diffValues(before, after interface{}, ignored map[string]bool) ([]*FieldChange, error) {
+	b, err := members(before, ignored)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := members(after, ignored)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffFields(b, a), nil
+}
+
+func diffFields(before, after map[string]json.RawMessage) []*FieldChange {
+	var fields []*FieldChange
+	for _, k := range unionKeys(before, after) {
+		if string(before[k]) != string(after[k]) {
+			fields = append(fields, &FieldChange{Field: k, Before: before[k], After: after[k]})
+		}
+	}
+
+	return fields
+}
+
+// members returns the JSON members of the value in canonical form, so that equal values have equal members.
+// Null members and the ignored ones are left out, a nil value has none.
+func members(v interface{}, ignored map[string]bool) (map[string]json.RawMessage, error) {
+	m := map[string]json.RawMessage{}
+	if isNil(v) {
+		return m, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	for k, r := range raw {
+		if ignored[k] {
+			continue
+		}
+
+		c, err := canonical(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if string(c) != "null" {
+			m[k] = c
+		}
+	}
+
+	return m, nil
+}
+
+// canonical re-encodes the JSON value, sorting the keys of the objects and leaving HTML unescaped,
+// as the texts of widgets are.
+func canonical(raw json.RawMessage) (json.RawMessage, error) {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// isNil reports whether the value is nil, including a typed nil pointer.
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/export"
+	"github.com/google/go-cmp/cmp"
+)
+
+func sticker(id string, x float64, text string) *miro.Sticker {
+	return &miro.Sticker{
+		WidgetBase: miro.WidgetBase{ID: id, Type: miro.WidgetTypeSticker, X: x, Y: 0},
+		Text:       text,
+		Style:      &miro.WidgetStyle{BackgroundColor: "#fff9b1"},
+	}
+}
+
+// snapshot returns the base snapshot the tests change.
+func snapshot() *export.Snapshot {
+	return &export.Snapshot{
+		Board: &miro.Board{ID: "board", Name: "Roadmap", Description: "Q1"},
+		Members: []*miro.BoardUserConnection{
+			{ID: "c1", User: &miro.MiniUser{ID: "alice", Name: "Alice"}, Role: miro.BoardRoleOwner},
+			{ID: "c2", User: &miro.MiniUser{ID: "bob", Name: "Bob"}, Role: miro.BoardRoleViewer},
+		},
+		Widgets: []miro.Widget{
+			sticker("s1", 0, "<p>Search</p>"),
+			sticker("s2", 100, "<p>Billing</p>"),
+			sticker("s3", 200, "<p>Exports</p>"),
+			sticker("s4", 300, "<p>Imports</p>"),
+		},
+		ExportedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// summary returns the operations of the diff as op, ID and changed members.
+func summary(d *Diff) []string {
+	var got []string
+	if d.Board != nil {
+		for _, f := range d.Board.Fields {
+			got = append(got, "board "+f.Field)
+		}
+	}
+
+	for _, m := range d.Members {
+		got = append(got, string(m.Op)+" "+m.UserID)
+	}
+
+	for _, c := range d.Widgets {
+		s := string(c.Op) + " " + c.ID
+		if c.NewID != "" {
+			s += "->" + c.NewID
+		}
+		for _, f := range c.Fields {
+			s += " " + f.Field
+		}
+		got = append(got, s)
+	}
+
+	return got
+}
+
+func TestCompare(t *testing.T) {
+	head := snapshot()
+	head.Board.Name = "Roadmap 2022"
+	head.Board.ModifiedAt = time.Now()
+	head.Members[1].Role = miro.BoardRoleEditor
+	head.Members = append(head.Members[1:], &miro.BoardUserConnection{ID: "c3", User: &miro.MiniUser{ID: "carol"}, Role: miro.BoardRoleViewer})
+	head.Widgets = []miro.Widget{
+		sticker("s1", 50, "<p>Search</p>"),
+		// Recreated by a copy with a new ID.
+		sticker("s9", 100, "<p>Billing</p>"),
+		sticker("s4", 300, "<p>Imports</p>"),
+		sticker("s5", 400, "<p>Reports</p>"),
+	}
+
+	tcs := map[string]struct {
+		opt  *DiffOptions
+		want []string
+	}{
+		"matched by content": {
+			want: []string{
+				"board name",
+				"removed alice",
+				"changed bob",
+				"added carol",
+				"changed s1 x",
+				"changed s2->s9",
+				"removed s3 style text type x y",
+				"added s5 style text type x y",
+			},
+		},
+		"matched by ID": {
+			opt: &DiffOptions{Similarity: -1},
+			want: []string{
+				"board name",
+				"removed alice",
+				"changed bob",
+				"added carol",
+				"changed s1 x",
+				"removed s2 style text type x y",
+				"removed s3 style text type x y",
+				"added s5 style text type x y",
+				"added s9 style text type x y",
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := Compare(snapshot(), head, tc.opt)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(summary(got), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestCompare_Fields(t *testing.T) {
+	head := snapshot()
+	head.Widgets[0] = sticker("s1", 0, "<p>Full text search</p>")
+
+	got, err := Compare(snapshot(), head, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []*FieldChange{{Field: "text", Before: json.RawMessage(`"<p>Search</p>"`), After: json.RawMessage(`"<p>Full text search</p>"`)}}
+	if diff := cmp.Diff(got.Widgets[0].Fields, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	got, err = Compare(snapshot(), snapshot(), nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if !got.Empty() {
+		t.Fatalf("Should be empty, got:%v", summary(got))
+	}
+}
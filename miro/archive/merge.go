@@ -0,0 +1,329 @@
+package archive
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/export"
+)
+
+// ConflictKind represents the kind of entity of a conflict.
+type ConflictKind string
+
+const (
+	ConflictBoard  ConflictKind = "board"
+	ConflictMember ConflictKind = "member"
+	ConflictWidget ConflictKind = "widget"
+)
+
+// Conflict represents a member changed differently in both snapshots, or, when Field is empty,
+// an entity removed in one snapshot and changed in the other.
+// ID is the Board ID, the User ID of a member or the Widget ID in the base snapshot.
+// The values are empty when the member or the entity is missing.
+type Conflict struct {
+	Kind   ConflictKind    `json:"kind"`
+	ID     string          `json:"id"`
+	Field  string          `json:"field,omitempty"`
+	Base   json.RawMessage `json:"base,omitempty"`
+	Ours   json.RawMessage `json:"ours,omitempty"`
+	Theirs json.RawMessage `json:"theirs,omitempty"`
+}
+
+// MergeResult represents the result of a three-way merge.
+// Snapshot takes our side of the conflicts, which are listed by kind, ID and field.
+type MergeResult struct {
+	Snapshot  *export.Snapshot `json:"snapshot"`
+	Conflicts []*Conflict      `json:"conflicts,omitempty"`
+}
+
+// Merge merges the changes from the base snapshot to our snapshot and to their snapshot, member by member.
+// Members changed on a single side take the changed value, and widgets are matched like Compare does.
+func Merge(base, ours, theirs *export.Snapshot, opt *DiffOptions) (*MergeResult, error) {
+	res := &MergeResult{Snapshot: &export.Snapshot{ExportedAt: ours.ExportedAt}}
+
+	id := ""
+	for _, b := range []*miro.Board{ours.Board, theirs.Board, base.Board} {
+		if b != nil {
+			id = b.ID
+			break
+		}
+	}
+
+	m, conflicts, err := mergeEntity(ConflictBoard, id, base.Board, ours.Board, theirs.Board, ignoredBoardMembers)
+	if err != nil {
+		return nil, err
+	}
+	res.Conflicts = append(res.Conflicts, conflicts...)
+
+	if m != nil {
+		res.Snapshot.Board = &miro.Board{}
+		if err := decode(m, res.Snapshot.Board); err != nil {
+			return nil, err
+		}
+	}
+
+	members, conflicts, err := mergeMembers(base.Members, ours.Members, theirs.Members)
+	if err != nil {
+		return nil, err
+	}
+	res.Snapshot.Members = members
+	res.Conflicts = append(res.Conflicts, conflicts...)
+
+	widgets, conflicts, err := mergeWidgets(base.Widgets, ours.Widgets, theirs.Widgets, opt)
+	if err != nil {
+		return nil, err
+	}
+	res.Snapshot.Widgets = widgets
+	res.Conflicts = append(res.Conflicts, conflicts...)
+
+	sort.SliceStable(res.Conflicts, func(i, j int) bool {
+		a, b := res.Conflicts[i], res.Conflicts[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+
+		return a.Field < b.Field
+	})
+
+	return res, nil
+}
+
+func mergeMembers(base, ours, theirs []*miro.BoardUserConnection) ([]*miro.BoardUserConnection, []*Conflict, error) {
+	b, o, t := membersByUser(base), membersByUser(ours), membersByUser(theirs)
+
+	all := map[string]*miro.BoardUserConnection{}
+	for _, m := range []map[string]*miro.BoardUserConnection{b, o, t} {
+		for id, c := range m {
+			all[id] = c
+		}
+	}
+
+	var merged []*miro.BoardUserConnection
+	var conflicts []*Conflict
+	for _, id := range unionKeys(all, nil) {
+		m, cs, err := mergeEntity(ConflictMember, id, b[id], o[id], t[id], ignoredMemberMembers)
+		if err != nil {
+			return nil, nil, err
+		}
+		conflicts = append(conflicts, cs...)
+
+		if m != nil {
+			c := &miro.BoardUserConnection{}
+			if err := decode(m, c); err != nil {
+				return nil, nil, err
+			}
+			merged = append(merged, c)
+		}
+	}
+
+	return merged, conflicts, nil
+}
+
+// mergeWidgets merges the widgets in the order of our snapshot, followed by the widgets only they added.
+func mergeWidgets(base, ours, theirs []miro.Widget, opt *DiffOptions) ([]miro.Widget, []*Conflict, error) {
+	oursPairs, err := matchWidgets(base, ours, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	theirsPairs, err := matchWidgets(base, theirs, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseOf := map[miro.Widget]miro.Widget{}
+	var removed []miro.Widget
+	for _, p := range oursPairs {
+		switch {
+		case p.after == nil:
+			removed = append(removed, p.before)
+		case p.before != nil:
+			baseOf[p.after] = p.before
+		}
+	}
+
+	theirsOf := map[miro.Widget]miro.Widget{}
+	added := map[string]miro.Widget{}
+	for _, p := range theirsPairs {
+		if p.before != nil {
+			theirsOf[p.before] = p.after
+		} else {
+			added[p.after.Base().ID] = p.after
+		}
+	}
+
+	var merged []miro.Widget
+	var conflicts []*Conflict
+	for _, o := range ours {
+		id := o.Base().ID
+		b := baseOf[o]
+		var t miro.Widget
+		if b != nil {
+			id = b.Base().ID
+			t = theirsOf[b]
+		} else {
+			t = added[id]
+			delete(added, id)
+		}
+
+		m, cs, err := mergeEntity(ConflictWidget, id, b, o, t, ignoredWidgetMembers)
+		if err != nil {
+			return nil, nil, err
+		}
+		conflicts = append(conflicts, cs...)
+
+		if m != nil {
+			w, err := decodeWidget(m)
+			if err != nil {
+				return nil, nil, err
+			}
+			merged = append(merged, w)
+		}
+	}
+
+	for _, b := range removed {
+		_, cs, err := mergeEntity(ConflictWidget, b.Base().ID, b, nil, theirsOf[b], ignoredWidgetMembers)
+		if err != nil {
+			return nil, nil, err
+		}
+		conflicts = append(conflicts, cs...)
+	}
+
+	for _, t := range theirs {
+		if _, ok := added[t.Base().ID]; ok {
+			merged = append(merged, t)
+		}
+	}
+
+	return merged, conflicts, nil
+}
+
+// mergeEntity merges the changes of an entity, nil when missing, and returns all its JSON members,
+// nil when it is removed.
+func mergeEntity(kind ConflictKind, id string, base, ours, theirs interface{}, ignored map[string]bool) (map[string]json.RawMessage, []*Conflict, error) {
+	bm, err := members(base, ignored)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	om, err := members(ours, ignored)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tm, err := members(theirs, ignored)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case isNil(ours) && isNil(theirs):
+		return nil, nil, nil
+	case isNil(base) && isNil(ours):
+		m, err := rawMembers(theirs)
+		return m, nil, err
+	case isNil(base) && isNil(theirs):
+		m, err := rawMembers(ours)
+		return m, nil, err
+	case !isNil(base) && isNil(ours):
+		if reflect.DeepEqual(bm, tm) {
+			return nil, nil, nil
+		}
+
+		return nil, []*Conflict{{Kind: kind, ID: id, Base: encode(bm), Theirs: encode(tm)}}, nil
+	case !isNil(base) && isNil(theirs):
+		if reflect.DeepEqual(bm, om) {
+			return nil, nil, nil
+		}
+
+		m, err := rawMembers(ours)
+		return m, []*Conflict{{Kind: kind, ID: id, Base: encode(bm), Ours: encode(om)}}, err
+	}
+
+	m, err := rawMembers(ours)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conflicts []*Conflict
+	for _, k := range unionKeys(om, unionSet(bm, tm)) {
+		b, o, t := bm[k], om[k], tm[k]
+		v := o
+		switch {
+		case string(o) == string(t), string(t) == string(b):
+		case string(o) == string(b):
+			v = t
+		default:
+			conflicts = append(conflicts, &Conflict{Kind: kind, ID: id, Field: k, Base: b, Ours: o, Theirs: t})
+		}
+
+		if v == nil {
+			delete(m, k)
+		} else {
+			m[k] = v
+		}
+	}
+
+	return m, conflicts, nil
+}
+
+// rawMembers returns all the JSON members of the value.
+func rawMembers(v interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// encode encodes the members of an entity, nil when it has none.
+func encode(m map[string]json.RawMessage) json.RawMessage {
+	if len(m) == 0 {
+		return nil
+	}
+
+	data, _ := json.Marshal(m)
+	return data
+}
+
+func decode(m map[string]json.RawMessage, v interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func decodeWidget(m map[string]json.RawMessage) (miro.Widget, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return miro.DecodeWidget(data)
+}
+
+func unionSet(a, b map[string]json.RawMessage) map[string]json.RawMessage {
+	m := make(map[string]json.RawMessage, len(a)+len(b))
+	for k, v := range a {
+		m[k] = v
+	}
+
+	for k, v := range b {
+		m[k] = v
+	}
+
+	return m
+}
@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge(t *testing.T) {
+	ours := snapshot()
+	ours.Board.Name = "Roadmap 2022"
+	ours.Widgets = []miro.Widget{
+		sticker("s1", 0, "<p>Full text search</p>"),
+		sticker("s2", 100, "<p>Billing v2</p>"),
+		sticker("s3", 200, "<p>Exports</p>"),
+		sticker("s6", 500, "<p>Mobile</p>"),
+	}
+
+	theirs := snapshot()
+	theirs.Board.Description = "Q1 and Q2"
+	theirs.Members[1].Role = miro.BoardRoleEditor
+	theirs.Widgets = []miro.Widget{
+		sticker("s1", 50, "<p>Search</p>"),
+		sticker("s2", 100, "<p>Invoices</p>"),
+		sticker("s4", 300, "<p>CSV imports</p>"),
+		sticker("s5", 400, "<p>Reports</p>"),
+	}
+
+	got, err := Merge(snapshot(), ours, theirs, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{got.Snapshot.Board.Name, got.Snapshot.Board.Description}, []string{"Roadmap 2022", "Q1 and Q2"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(got.Snapshot.Members[1].Role, miro.BoardRoleEditor); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	var widgets []string
+	for _, w := range got.Snapshot.Widgets {
+		s := w.(*miro.Sticker)
+		widgets = append(widgets, s.ID+" "+s.Text)
+	}
+
+	want := []string{
+		"s1 <p>Full text search</p>",
+		"s2 <p>Billing v2</p>",
+		"s6 <p>Mobile</p>",
+		"s5 <p>Reports</p>",
+	}
+	if diff := cmp.Diff(widgets, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(got.Snapshot.Widgets[0].Base().X, float64(50)); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	var conflicts []string
+	for _, c := range got.Conflicts {
+		conflicts = append(conflicts, string(c.Kind)+" "+c.ID+" "+c.Field)
+	}
+
+	if diff := cmp.Diff(conflicts, []string{"widget s2 text", "widget s4 "}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	want0 := &Conflict{
+		Kind:   ConflictWidget,
+		ID:     "s2",
+		Field:  "text",
+		Base:   json.RawMessage(`"<p>Billing</p>"`),
+		Ours:   json.RawMessage(`"<p>Billing v2</p>"`),
+		Theirs: json.RawMessage(`"<p>Invoices</p>"`),
+	}
+	if diff := cmp.Diff(got.Conflicts[0], want0); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestMerge_Recreated(t *testing.T) {
+	// They recreated s2 as s9 and moved it, we edited its text.
+	ours := snapshot()
+	ours.Widgets[1] = sticker("s2", 100, "<p>Billing v2</p>")
+
+	theirs := snapshot()
+	theirs.Widgets[1] = sticker("s9", 150, "<p>Billing</p>")
+
+	got, err := Merge(snapshot(), ours, theirs, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if len(got.Conflicts) != 0 {
+		t.Fatalf("Should not conflict, got:%v", got.Conflicts)
+	}
+
+	if diff := cmp.Diff(len(got.Snapshot.Widgets), 4); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	s := got.Snapshot.Widgets[1].(*miro.Sticker)
+	if diff := cmp.Diff([]interface{}{s.ID, s.X, s.Text}, []interface{}{"s2", float64(150), "<p>Billing v2</p>"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,87 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Text writes the diff for review, one line per change, with a +, - or ~ prefix for an added, removed or
+// changed entity, followed by the members of added widgets and the changed members of the others.
+func Text(w io.Writer, d *Diff) error {
+	bw := bufio.NewWriter(w)
+
+	if d.Board != nil {
+		fmt.Fprintln(bw, "board")
+		for _, f := range d.Board.Fields {
+			fmt.Fprintf(bw, "  ~ %s\n", field(f))
+		}
+	}
+
+	if len(d.Members) > 0 {
+		fmt.Fprintln(bw, "members")
+		for _, m := range d.Members {
+			name := m.UserID
+			if m.Name != "" {
+				name = fmt.Sprintf("%s (%s)", m.Name, m.UserID)
+			}
+
+			fmt.Fprintf(bw, "  %s %s\n", prefix(m.Op), name)
+			if m.Op != OpRemoved {
+				for _, f := range m.Fields {
+					fmt.Fprintf(bw, "      %s\n", field(f))
+				}
+			}
+		}
+	}
+
+	if len(d.Widgets) > 0 {
+		fmt.Fprintln(bw, "widgets")
+		for _, c := range d.Widgets {
+			fmt.Fprintf(bw, "  %s %s %s", prefix(c.Op), c.Type, c.ID)
+			if c.NewID != "" {
+				fmt.Fprintf(bw, " -> %s (matched by content %.2f)", c.NewID, c.Similarity)
+			}
+			fmt.Fprintln(bw)
+
+			if c.Op != OpRemoved {
+				for _, f := range c.Fields {
+					fmt.Fprintf(bw, "      %s\n", field(f))
+				}
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+// JSON writes the diff as indented JSON.
+func JSON(w io.Writer, d *Diff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(d)
+}
+
+func prefix(op Op) string {
+	switch op {
+	case OpAdded:
+		return "+"
+	case OpRemoved:
+		return "-"
+	}
+
+	return "~"
+}
+
+func field(f *FieldChange) string {
+	switch {
+	case f.Before == nil:
+		return fmt.Sprintf("%s: %s", f.Field, f.After)
+	case f.After == nil:
+		return fmt.Sprintf("%s: %s -> (none)", f.Field, f.Before)
+	}
+
+	return fmt.Sprintf("%s: %s -> %s", f.Field, f.Before, f.After)
+}
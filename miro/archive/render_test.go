@@ -0,0 +1,84 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestText(t *testing.T) {
+	head := snapshot()
+	head.Board.Description = "Q2"
+	head.Members[1].Role = miro.BoardRoleEditor
+	head.Widgets = []miro.Widget{
+		sticker("s1", 50, "<p>Search</p>"),
+		sticker("s9", 100, "<p>Billing</p>"),
+		sticker("s3", 200, "<p>Exports</p>"),
+		sticker("s4", 300, "<p>Imports</p>"),
+		&miro.Text{WidgetBase: miro.WidgetBase{ID: "t1", Type: miro.WidgetTypeText}, Text: "Q2"},
+	}
+	head.Members = head.Members[1:]
+
+	d, err := Compare(snapshot(), head, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := Text(buf, d); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `board
+  ~ description: "Q1" -> "Q2"
+members
+  - Alice (alice)
+  ~ Bob (bob)
+      role: "viewer" -> "editor"
+widgets
+  ~ sticker s1
+      x: 0 -> 50
+  ~ sticker s2 -> s9 (matched by content 1.00)
+  + text t1
+      text: "Q2"
+      type: "text"
+      x: 0
+      y: 0
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	head := snapshot()
+	head.Widgets[0] = sticker("s1", 0, "<p>Full text search</p>")
+
+	d, err := Compare(snapshot(), head, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := JSON(buf, d); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got := &Diff{}
+	if err := json.Unmarshal(buf.Bytes(), got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &Diff{Widgets: []*WidgetChange{{
+		Op:     OpChanged,
+		ID:     "s1",
+		Type:   miro.WidgetTypeSticker,
+		Fields: []*FieldChange{{Field: "text", Before: json.RawMessage(`"<p>Search</p>"`), After: json.RawMessage(`"<p>Full text search</p>"`)}},
+	}}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
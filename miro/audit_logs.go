@@ -3,9 +3,7 @@ package miro
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -21,6 +19,7 @@ type AuditLogsService service
 // AuditLog object represents Miro Log.
 //
 // API doc: https://developers.miro.com/reference#log-object
+//
 //go:generate gomodifytags -file $GOFILE -struct AuditLog -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct AuditLog -add-tags json -w -transform camelcase
 type AuditLog struct {
@@ -30,6 +29,8 @@ type AuditLog struct {
 	NextLink string `json:"nextLink"`
 	PrevLink string `json:"prevLink"`
 	Data     []Data `json:"data"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 //go:generate gomodifytags -file $GOFILE -struct Data -clear-tags -w
@@ -41,6 +42,8 @@ type Data struct {
 	CreatedAt time.Time `json:"createdAt"`
 	CreatedBy *MiniUser `json:"createdBy"`
 	Context   *Context  `json:"context"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 //go:generate gomodifytags -file $GOFILE -struct Context -clear-tags -w
@@ -49,126 +52,100 @@ type Context struct {
 	Organization *Organization `json:"organization"`
 	Team         *MiniTeam     `json:"team"`
 	IP           string        `json:"ip"`
-}
 
-//go:generate gomodifytags -file $GOFILE -struct Organization -clear-tags -w
-//go:generate gomodifytags --file $GOFILE --struct Organization -add-tags json -w -transform camelcase
-type Organization struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 //go:generate gomodifytags -file $GOFILE -struct Detail -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Detail -add-tags json -w -transform camelcase
 type Detail struct {
 	Role string `json:"role"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Get gets logs by condition.
 //
 // API doc: https://developers.miro.com/reference#get-logs
-func (s *AuditLogsService) Get(ctx context.Context) (*AuditLog, error) {
+func (s *AuditLogsService) Get(ctx context.Context) (*AuditLog, *Response, error) {
+	ctx = withMethod(ctx, "AuditLogsService.Get")
+
 	req, err := s.client.NewGetRequest(auditLogsPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
+	return do[AuditLog](ctx, s.client, req, http.StatusOK)
+}
+
+// UnmarshalJSON decodes the audit log keeping the members unknown to this package in Extra.
+func (l *AuditLog) UnmarshalJSON(data []byte) error {
+	type alias AuditLog
+	extra, err := unmarshalExtra(data, (*alias)(l))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if http.StatusBadRequest <= resp.StatusCode && resp.StatusCode <= http.StatusInsufficientStorage {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
+	l.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the audit log with the members in Extra.
+func (l AuditLog) MarshalJSON() ([]byte, error) {
+	type alias AuditLog
+	return marshalExtra(alias(l), l.Extra)
+}
 
-	l := &AuditLog{}
-	if err := json.NewDecoder(resp.Body).Decode(l); err != nil {
-		return nil, err
+// UnmarshalJSON decodes the data keeping the members unknown to this package in Extra.
+func (d *Data) UnmarshalJSON(data []byte) error {
+	type alias Data
+	extra, err := unmarshalExtra(data, (*alias)(d))
+	if err != nil {
+		return err
 	}
 
-	return l, nil
+	d.Extra = extra
+	return nil
 }
 
-func (l *AuditLog) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
+// MarshalJSON encodes the data with the members in Extra.
+func (d Data) MarshalJSON() ([]byte, error) {
+	type alias Data
+	return marshalExtra(alias(d), d.Extra)
+}
 
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the context keeping the members unknown to this package in Extra.
+func (c *Context) UnmarshalJSON(data []byte) error {
+	type alias Context
+	extra, err := unmarshalExtra(data, (*alias)(c))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "limit" {
-			l.Limit = int(v.(float64))
-		}
-
-		if strings.ToLower(k) == "offset" {
-			l.Offset = int(v.(float64))
-		}
-
-		if strings.ToLower(k) == "size" {
-			l.Size = int(v.(float64))
-		}
-
-		if strings.ToLower(k) == "nextlink" {
-			l.NextLink = v.(string)
-		}
-
-		if strings.ToLower(k) == "prevlink" {
-			l.PrevLink = v.(string)
-		}
-
-		if strings.ToLower(k) == "data" {
-			data, err := unmarshalData(v.([]interface{}))
-			if err != nil {
-				return err
-			}
-			l.Data = data
-		}
-	}
-
+	c.Extra = extra
 	return nil
 }
 
-func unmarshalData(d []interface{}) ([]Data, error) {
-	data := make([]Data, len(d))
-	for i, v := range d {
-		datum := Data{}
-		rawDatum := v.(map[string]interface{})
-
-		for k, v := range rawDatum {
-			if strings.ToLower(k) == "id" {
-				datum.ID = v.(string)
-			}
-
-			if strings.ToLower(k) == "event" {
-				datum.Event = v.(string)
-			}
-
-			if strings.ToLower(k) == "createdAt" {
-				at, err := time.Parse(time.RFC3339, v.(string))
-				if err != nil {
-					return []Data{}, err
-				}
-				datum.CreatedAt = at
-			}
-
-			if strings.ToLower(k) == "details" {
-				details := &Detail{}
-				fmt.Println("hoho")
-				js, err := json.Marshal(v)
-				if err != nil {
-					return nil, err
-				}
-				r := strings.NewReader(string(js))
-				json.NewDecoder(r).Decode(details)
-				datum.Details = details
-			}
-		}
-
-		data[i] = datum
+// MarshalJSON encodes the context with the members in Extra.
+func (c Context) MarshalJSON() ([]byte, error) {
+	type alias Context
+	return marshalExtra(alias(c), c.Extra)
+}
+
+// UnmarshalJSON decodes the detail keeping the members unknown to this package in Extra.
+func (d *Detail) UnmarshalJSON(data []byte) error {
+	type alias Detail
+	extra, err := unmarshalExtra(data, (*alias)(d))
+	if err != nil {
+		return err
 	}
 
-	return data, nil
+	d.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the detail with the members in Extra.
+func (d Detail) MarshalJSON() ([]byte, error) {
+	type alias Detail
+	return marshalExtra(alias(d), d.Extra)
 }
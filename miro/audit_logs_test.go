@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -44,6 +45,7 @@ func getAuditLogJSON() string {
 }
 
 func getAuditLog() *AuditLog {
+	createdAt, _ := time.Parse(time.RFC3339, "1994-03-01T10:00:00Z")
 
 	return &AuditLog{
 		Limit:    testAuditLogsLimit,
@@ -56,6 +58,15 @@ func getAuditLog() *AuditLog {
 			Details: &Detail{
 				Role: "OWNER",
 			},
+			CreatedAt: createdAt,
+			Context: &Context{
+				Organization: &Organization{
+					ID:   "miro",
+					Name: "miro",
+					Type: "organization",
+				},
+			},
+			Extra: extra("type", `"event"`),
 		}},
 	}
 }
@@ -76,7 +87,7 @@ func TestAuditLogsService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getAuditLogJSON()))
 			})
 
-			got, err := client.AuditLogs.Get(context.Background())
+			got, _, err := client.AuditLogs.Get(context.Background())
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -17,18 +17,31 @@ func getAuthorizationInfoJSON(id string) string {
       "boards:read",
       "team:read"
     ],
+	"user": {
+      "type": "user",
+      "name": "Sergey",
+      "id": "user"
+    },
+    "team": {
+      "type": "team",
+      "name": "Miro",
+      "id": "team"
+    },
 	"id": "%s",
     "createdAt": "1995-06-15T10:00:00Z"
 }`, id)
 }
 
 func getAuthorizationInfo(id string) *AuthorizationInfo {
-	createdAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
+	createdAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
 
 	return &AuthorizationInfo{
 		ID:        id,
 		Scopes:    []string{"boards:read", "team:read"},
+		User:      &MiniUser{ID: "user", Name: "Sergey", Extra: extra("type", `"user"`)},
+		Team:      &MiniTeam{ID: "team", Name: "Miro", Extra: extra("type", `"team"`)},
 		CreatedAt: createdAt,
+		Extra:     extra("type", `"team-user-connection"`),
 	}
 }
 
@@ -49,7 +62,7 @@ func TestAuthzService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getAuthorizationInfoJSON(tc.id)))
 			})
 
-			got, err := client.AuthzInfo.Get(context.Background())
+			got, _, err := client.AuthzInfo.Get(context.Background())
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -18,45 +17,117 @@ const (
 // API doc: https://developers.miro.com/reference#board-user-connection-object
 type BoardUserConnectionService service
 
+// BoardRole represents the role of a user on a board.
+type BoardRole string
+
+const (
+	BoardRoleViewer    BoardRole = "viewer"
+	BoardRoleCommenter BoardRole = "commenter"
+	BoardRoleEditor    BoardRole = "editor"
+	BoardRoleOwner     BoardRole = "owner"
+
+	// BoardRoleCoowner is only available in the API v2.
+	BoardRoleCoowner BoardRole = "coowner"
+)
+
 // BoardUserConnection object represents Miro BoardUserConnection.
 //
 // API doc: https://developers.miro.com/reference#board-user-connection-object
+//
 //go:generate gomodifytags -file $GOFILE -struct BoardUserConnection -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct BoardUserConnection -add-tags json -w -transform camelcase
 type BoardUserConnection struct {
 	ID         string    `json:"id"`
 	User       *MiniUser `json:"user"`
-	Role       string    `json:"role"`
+	Role       BoardRole `json:"role"`
 	CreatedAt  time.Time `json:"createdAt"`
 	ModifiedAt time.Time `json:"modifiedAt"`
 	CreatedBy  *MiniUser `json:"createdBy"`
 	ModifiedBy *MiniUser `json:"modifiedBy"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Get gets board user connection by BoardUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#get-board-user-connection
-func (s *BoardUserConnectionService) Get(ctx context.Context, id string) (*BoardUserConnection, error) {
+func (s *BoardUserConnectionService) Get(ctx context.Context, id string) (*BoardUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.Get")
+
 	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", boardUserConnectionsPath, id))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
+	return do[BoardUserConnection](ctx, s.client, req, http.StatusOK)
+}
+
+// ListBoardUserConnectionsResponse represents list response from Miro
+//
+//go:generate gomodifytags -file $GOFILE -struct ListBoardUserConnectionsResponse -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ListBoardUserConnectionsResponse -add-tags json -w -transform camelcase
+type ListBoardUserConnectionsResponse struct {
+	Limit  int                    `json:"limit"`
+	Offset int                    `json:"offset"`
+	Size   int                    `json:"size"`
+	Data   []*BoardUserConnection `json:"data"`
+}
+
+// ListMembers lists board user connections of the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference#get-board-user-connections
+func (s *BoardUserConnectionService) ListMembers(ctx context.Context, boardID string, opt *ListOptions) (*ListBoardUserConnectionsResponse, *Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.ListMembers")
+
+	req, err := s.client.NewGetRequest(addListOptions(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, userConnectionsPath), opt))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	return do[ListBoardUserConnectionsResponse](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAllMembers lists every board user connection of the board by following the pagination.
+func (s *BoardUserConnectionService) ListAllMembers(ctx context.Context, boardID string) ([]*BoardUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.ListAllMembers")
+
+	opt := &ListOptions{}
+	conns := []*BoardUserConnection{}
+
+	for {
+		page, resp, err := s.ListMembers(ctx, boardID, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		conns = append(conns, page.Data...)
+		opt.Offset += len(page.Data)
+
+		if len(page.Data) == 0 || opt.Offset >= page.Size {
+			return conns, resp, nil
+		}
 	}
+}
+
+// TransferOwnership makes the user the owner of the board.
+// The user must already be a member of the board.
+func (s *BoardUserConnectionService) TransferOwnership(ctx context.Context, boardID, userID string) (*BoardUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.TransferOwnership")
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+	conns, resp, err := s.ListAllMembers(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	conn := &BoardUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return nil, err
+	for _, conn := range conns {
+		if conn.User != nil && conn.User.ID == userID {
+			return s.Updates(ctx, conn.ID, &UpdateBoardUserConnectionRequest{
+				Role: BoardRoleOwner,
+			})
+		}
 	}
 
-	return conn, nil
+	return nil, resp, fmt.Errorf("user %s is not a member of board %s", userID, boardID)
 }
 
 // UpdateBoardUserConnectionRequest represents request to update board user connection
@@ -64,145 +135,52 @@ func (s *BoardUserConnectionService) Get(ctx context.Context, id string) (*Board
 //go:generate gomodifytags -file $GOFILE -struct UpdateBoardUserConnectionRequest -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct UpdateBoardUserConnectionRequest -add-tags json -w -transform camelcase
 type UpdateBoardUserConnectionRequest struct {
-	Role string `json:"role"`
+	Role BoardRole `json:"role"`
 }
 
 // Update updates board user connection by BoardUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#update-board-user-connection
-func (s *BoardUserConnectionService) Updates(ctx context.Context, id string, request *UpdateBoardUserConnectionRequest) (*BoardUserConnection, error) {
-	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", boardUserConnectionsPath, id), request)
-	if err != nil {
-		return nil, err
-	}
+func (s *BoardUserConnectionService) Updates(ctx context.Context, id string, request *UpdateBoardUserConnectionRequest) (*BoardUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.Updates")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", boardUserConnectionsPath, id), request)
 	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+		return nil, nil, err
 	}
 
-	conn := &BoardUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return nil, err
-	}
-
-	return conn, nil
+	return do[BoardUserConnection](ctx, s.client, req, http.StatusOK)
 }
 
 // Delete deletes board user connection by BoardUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#delete-board-user-connection
-func (s *BoardUserConnectionService) Delete(ctx context.Context, id string) error {
-	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", boardUserConnectionsPath, id))
-	if err != nil {
-		return err
-	}
+func (s *BoardUserConnectionService) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withMethod(ctx, "BoardUserConnectionService.Delete")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", boardUserConnectionsPath, id))
 	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	conn := &BoardUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusOK, http.StatusNoContent)
+	return resp, err
 }
 
-func (c *BoardUserConnection) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
-
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the board user connection keeping the members unknown to this package in Extra.
+func (c *BoardUserConnection) UnmarshalJSON(data []byte) error {
+	type alias BoardUserConnection
+	extra, err := unmarshalExtra(data, (*alias)(c))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			c.ID = v.(string)
-		}
-
-		if strings.ToLower(k) == "role" {
-			c.Role = v.(string)
-		}
-
-		if strings.ToLower(k) == "createdAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			c.CreatedAt = at
-		}
-
-		if strings.ToLower(k) == "modifiedAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			c.ModifiedAt = at
-		}
-
-		if strings.ToLower(k) == "user" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
-
-			c.User = user
-		}
-
-		if strings.ToLower(k) == "createdBy" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
-
-			c.CreatedBy = user
-		}
-
-		if strings.ToLower(k) == "modifiedBy" {
-			user := &MiniUser{}
-			u := v.(map[string]string)
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v
-				}
-			}
-
-			c.ModifiedBy = user
-		}
-	}
-
+	c.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the board user connection with the members in Extra.
+func (c BoardUserConnection) MarshalJSON() ([]byte, error) {
+	type alias BoardUserConnection
+	return marshalExtra(alias(c), c.Extra)
+}
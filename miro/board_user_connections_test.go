@@ -2,8 +2,10 @@ package miro
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,9 +29,11 @@ func getBoardUserConnection(id string) *BoardUserConnection {
 		ID:   id,
 		Role: "admin",
 		User: &MiniUser{
-			ID:   "user",
-			Name: "Sergey",
+			ID:    "user",
+			Name:  "Sergey",
+			Extra: extra("type", `"user"`),
 		},
+		Extra: extra("type", `"team-user-connection"`),
 	}
 }
 
@@ -50,7 +54,7 @@ func TestBoardUserConnectionService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getBoardUserConnectionJSON(tc.id)))
 			})
 
-			got, err := client.BoardUserConnection.Get(context.Background(), tc.id)
+			got, _, err := client.BoardUserConnection.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -61,3 +65,134 @@ func TestBoardUserConnectionService_Get(t *testing.T) {
 		})
 	}
 }
+
+func getBoardUserConnectionListJSON(size, offset int, ids ...string) string {
+	data := make([]string, len(ids))
+	for i, id := range ids {
+		data[i] = getBoardUserConnectionJSON(id)
+	}
+
+	return fmt.Sprintf(`{
+	"limit": 2,
+	"offset": %d,
+	"size": %d,
+	"data": [%s]
+}`, offset, size, strings.Join(data, ","))
+}
+
+func TestBoardUserConnectionService_ListMembers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		opt     *ListOptions
+		want    *ListBoardUserConnectionsResponse
+	}{
+		"ok": {"1", &ListOptions{Limit: 2, Offset: 2}, &ListBoardUserConnectionsResponse{
+			Limit:  2,
+			Offset: 2,
+			Size:   4,
+			Data:   []*BoardUserConnection{getBoardUserConnection("3"), getBoardUserConnection("4")},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, userConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("offset"); got != "2" {
+					t.Fatalf("offset not expected, got:%s", got)
+				}
+				fmt.Fprint(w, getBoardUserConnectionListJSON(4, 2, "3", "4"))
+			})
+
+			got, _, err := client.BoardUserConnection.ListMembers(context.Background(), tc.boardID, tc.opt)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardUserConnectionService_ListAllMembers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		want    []*BoardUserConnection
+	}{
+		"ok": {"1", []*BoardUserConnection{
+			getBoardUserConnection("1"),
+			getBoardUserConnection("2"),
+			getBoardUserConnection("3"),
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, userConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("offset") {
+				case "":
+					fmt.Fprint(w, getBoardUserConnectionListJSON(3, 0, "1", "2"))
+				case "2":
+					fmt.Fprint(w, getBoardUserConnectionListJSON(3, 2, "3"))
+				default:
+					t.Fatalf("unexpected offset: %s", r.URL.Query().Get("offset"))
+				}
+			})
+
+			got, _, err := client.BoardUserConnection.ListAllMembers(context.Background(), tc.boardID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardUserConnectionService_TransferOwnership(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		userID  string
+		wantErr bool
+	}{
+		"ok":         {"1", "user", false},
+		"not member": {"1", "unknown", true},
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/%s/1/%s", boardsPath, userConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getBoardUserConnectionListJSON(1, 0, "10"))
+	})
+
+	mux.HandleFunc(fmt.Sprintf("/%s/10", boardUserConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+		req := &UpdateBoardUserConnectionRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if req.Role != BoardRoleOwner {
+			t.Fatalf("role not expected, got:%s", req.Role)
+		}
+		fmt.Fprint(w, getBoardUserConnectionJSON("10"))
+	})
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			_, _, err := client.BoardUserConnection.TransferOwnership(context.Background(), tc.boardID, tc.userID)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error not expected, got:%v", err)
+			}
+		})
+	}
+}
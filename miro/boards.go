@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -21,48 +20,66 @@ type BoardsService service
 // Board object represents Miro Board.
 //
 // API doc: https://developers.miro.com/reference#board-object
+//
 //go:generate gomodifytags -file $GOFILE -struct Board -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Board -add-tags json -w -transform camelcase
 type Board struct {
-	ID                    string              `json:"id"`
-	Name                  string              `json:"name"`
-	Description           string              `json:"description"`
-	ImageURL              string              `json:"imageURL"`
-	CreatedAt             time.Time           `json:"createdAt"`
-	ModifiedAt            time.Time           `json:"modifiedAt"`
-	CreatedBy             *MiniUser           `json:"createdBy"`
-	ModifiedBy            *MiniUser           `json:"modifiedBy"`
-	Owner                 *MiniUser           `json:"owner"`
-	Picture               *MiniPicture        `json:"picture"`
-	ViewLink              string              `json:"viewLink"`
-	SharingPolicy         *SharingPolicy      `json:"sharingPolicy"`
-	CurrentUserConnection *TeamUserConnection `json:"currentUserConnection"`
+	ID                    string               `json:"id"`
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	ImageURL              string               `json:"imageURL"`
+	CreatedAt             time.Time            `json:"createdAt"`
+	ModifiedAt            time.Time            `json:"modifiedAt"`
+	CreatedBy             *MiniUser            `json:"createdBy"`
+	ModifiedBy            *MiniUser            `json:"modifiedBy"`
+	Owner                 *MiniUser            `json:"owner"`
+	Picture               *MiniPicture         `json:"picture"`
+	ViewLink              string               `json:"viewLink"`
+	SharingPolicy         *SharingPolicy       `json:"sharingPolicy"`
+	CurrentUserConnection *BoardUserConnection `json:"currentUserConnection"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // SharingPolicy object represents the policy for the board
+//
 //go:generate gomodifytags -file $GOFILE -struct SharingPolicy -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct SharingPolicy -add-tags json -w -transform camelcase
 type SharingPolicy struct {
-	Access     string `json:"access"`
-	TeamAccess string `json:"teamAccess"`
+	Access     AccessLevel `json:"access"`
+	TeamAccess AccessLevel `json:"teamAccess"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
+// AccessLevel represents the access level granted by a sharing policy.
+type AccessLevel string
+
+const (
+	AccessLevelPrivate AccessLevel = "private"
+	AccessLevelView    AccessLevel = "view"
+	AccessLevelComment AccessLevel = "comment"
+	AccessLevelEdit    AccessLevel = "edit"
+)
+
 //go:generate gomodifytags -file $GOFILE -struct MiniBoard -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct MiniBoard -add-tags json -w -transform camelcase
 type MiniBoard struct {
-	ID                    string              `json:"id"`
-	Name                  string              `json:"name"`
-	Description           string              `json:"description"`
-	ImageURL              string              `json:"imageURL"`
-	CreatedAt             time.Time           `json:"createdAt"`
-	ModifiedAt            time.Time           `json:"modifiedAt"`
-	CreatedBy             *User               `json:"createdBy"`
-	ModifiedBy            *User               `json:"modifiedBy"`
-	Owner                 *User               `json:"owner"`
-	Picture               *Picture            `json:"picture"`
-	ViewLink              string              `json:"viewLink"`
-	SharingPolicy         *SharingPolicy      `json:"sharingPolicy"`
-	CurrentUserConnection *TeamUserConnection `json:"currentUserConnection"`
+	ID                    string               `json:"id"`
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	ImageURL              string               `json:"imageURL"`
+	CreatedAt             time.Time            `json:"createdAt"`
+	ModifiedAt            time.Time            `json:"modifiedAt"`
+	CreatedBy             *User                `json:"createdBy"`
+	ModifiedBy            *User                `json:"modifiedBy"`
+	Owner                 *User                `json:"owner"`
+	Picture               *Picture             `json:"picture"`
+	ViewLink              string               `json:"viewLink"`
+	SharingPolicy         *SharingPolicy       `json:"sharingPolicy"`
+	CurrentUserConnection *BoardUserConnection `json:"currentUserConnection"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ListBoardsResponse represents list response from Miro
@@ -79,32 +96,15 @@ type ListBoardsResponse struct {
 // Get gets board by Board ID.
 //
 // API doc: https://developers.miro.com/reference#get-board
-func (s *BoardsService) Get(ctx context.Context, id string) (*Board, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", boardsPath, id))
-	if err != nil {
-		return nil, err
-	}
+func (s *BoardsService) Get(ctx context.Context, id string) (*Board, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.Get")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", boardsPath, id))
 	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
-		}
-		return nil, fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
-	}
-
-	board := &Board{}
-	if err := json.NewDecoder(resp.Body).Decode(board); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return board, nil
+	return do[Board](ctx, s.client, req, http.StatusOK)
 }
 
 // CreateBoardRequest represents create board request payload.
@@ -120,32 +120,15 @@ type CreateBoardRequest struct {
 // Create creates board by Board Request.
 //
 // API doc: https://developers.miro.com/reference#create-board
-func (s *BoardsService) Create(ctx context.Context, b *CreateBoardRequest) (*Board, error) {
-	req, err := s.client.NewPostRequest(boardsPath, b)
-	if err != nil {
-		return nil, err
-	}
+func (s *BoardsService) Create(ctx context.Context, b *CreateBoardRequest) (*Board, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.Create")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewPostRequest(boardsPath, b)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
-		}
-		return nil, fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
-	}
-
-	board := &Board{}
-	if err := json.NewDecoder(resp.Body).Decode(board); err != nil {
-		return nil, err
-	}
-
-	return board, nil
+	return do[Board](ctx, s.client, req, http.StatusCreated)
 }
 
 // ShareBoardRequest represents update board request payload.
@@ -159,32 +142,94 @@ type ShareBoardRequest struct {
 // Share shares board by Board ID.
 //
 // API doc: https://developers.miro.com/reference#share-board
-func (s *BoardsService) Share(ctx context.Context, id string, request *ShareBoardRequest) (*ListBoardsResponse, error) {
+func (s *BoardsService) Share(ctx context.Context, id string, request *ShareBoardRequest) (*ListBoardsResponse, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.Share")
+
 	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/share", boardsPath, id), request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, err
+	return do[ListBoardsResponse](ctx, s.client, req, http.StatusOK)
+}
+
+// BoardInvitation represents an invitee of the board and the role to grant.
+type BoardInvitation struct {
+	Email string
+	Role  BoardRole
+}
+
+// ShareBoardWithRolesRequest represents share board request payload with per-email roles.
+type ShareBoardWithRolesRequest struct {
+	Invitations []*BoardInvitation
+	Message     string
+}
+
+// shareBoardRoleRequest is the payload of a single share call, Miro grants one role per call.
+type shareBoardRoleRequest struct {
+	Emails  []string  `json:"emails"`
+	Role    BoardRole `json:"role"`
+	Message string    `json:"message,omitempty"`
+}
+
+// ShareWithRoles shares board by Board ID granting each email its own role.
+// Since Miro grants a single role per share call, one call is made for each distinct role.
+// Every role is tried even if some fail, the connections of the emails shared are returned
+// with *InviteError telling the emails shared and the emails failed.
+//
+// API doc: https://developers.miro.com/reference#share-board
+func (s *BoardsService) ShareWithRoles(ctx context.Context, id string, request *ShareBoardWithRolesRequest) ([]*BoardUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.ShareWithRoles")
+
+	roles := []BoardRole{}
+	emails := map[BoardRole][]string{}
+	for _, inv := range request.Invitations {
+		if inv.Role == "" {
+			return nil, nil, fmt.Errorf("role of %s is empty", inv.Email)
+		}
+
+		if inv.Role == BoardRoleOwner {
+			return nil, nil, fmt.Errorf("role %s cannot be granted by sharing, use TransferOwnership", inv.Role)
+		}
+
+		if _, ok := emails[inv.Role]; !ok {
+			roles = append(roles, inv.Role)
+		}
+		emails[inv.Role] = append(emails[inv.Role], inv.Email)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
+	var resp *Response
+	conns := []*BoardUserConnection{}
+	shareErr := &InviteError{Invited: []string{}, Failed: []string{}}
+	for _, role := range roles {
+		req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/share", boardsPath, id), &shareBoardRoleRequest{
+			Emails:  emails[role],
+			Role:    role,
+			Message: request.Message,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		list, r, err := do[ListBoardUserConnectionsResponse](ctx, s.client, req, http.StatusOK)
+		resp = r
+		if err != nil {
+			shareErr.Failed = append(shareErr.Failed, emails[role]...)
+			if shareErr.Err == nil {
+				shareErr.Err = err
+			}
+			continue
 		}
-		return nil, fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
+
+		shareErr.Invited = append(shareErr.Invited, emails[role]...)
+		conns = append(conns, list.Data...)
 	}
 
-	boardList := &ListBoardsResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(boardList); err != nil {
-		return nil, err
+	if shareErr.Err != nil {
+		return conns, resp, shareErr
 	}
 
-	return boardList, nil
+	return conns, resp, nil
 }
 
 // UpdateBoardRequest represents update board request payload.
@@ -200,212 +245,132 @@ type UpdateBoardRequest struct {
 // Update board.
 //
 // API doc: https://developers.miro.com/reference#update-board
-func (s *BoardsService) Update(ctx context.Context, id string, b *UpdateBoardRequest) (*Board, error) {
+func (s *BoardsService) Update(ctx context.Context, id string, b *UpdateBoardRequest) (*Board, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.Update")
+
 	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", boardsPath, id), b)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
-		}
-		return nil, fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
-	}
-
-	board := &Board{}
-	if err := json.NewDecoder(resp.Body).Decode(board); err != nil {
-		return nil, err
-	}
-
-	return board, nil
+	return do[Board](ctx, s.client, req, http.StatusOK)
 }
 
 // Delete deletes board by Board Request.
 //
 // API doc: No document yet
-func (s *BoardsService) Delete(ctx context.Context, id string) error {
-	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", boardsPath, id))
-	if err != nil {
-		return err
-	}
+func (s *BoardsService) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withMethod(ctx, "BoardsService.Delete")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", boardsPath, id))
 	if err != nil {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return err
-		}
-		return respErr
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return err
-		}
-		return fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
+		return nil, err
 	}
 
-	return nil
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
 }
 
 // GetCurrentUserBoards gets current user's boards by Teams ID.
 //
 // API doc: https://developers.miro.com/reference#get-team-boards
-func (s *BoardsService) GetCurrentUserBoards(ctx context.Context, teamID string) (*ListBoardsResponse, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/boards", teamsPath, teamID))
-	if err != nil {
-		return nil, err
-	}
+func (s *BoardsService) GetCurrentUserBoards(ctx context.Context, teamID string) (*ListBoardsResponse, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.GetCurrentUserBoards")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/boards", teamsPath, teamID))
 	if err != nil {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
-		}
-		return nil, respErr
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		respErr := &RespError{}
-		if err := json.NewDecoder(resp.Body).Decode(respErr); err != nil {
-			return nil, err
-		}
-		return nil, fmt.Errorf("status code not expected, got:%d, message:%s", resp.StatusCode, respErr.Message)
-	}
-
-	boardList := &ListBoardsResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(boardList); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return boardList, nil
+	return do[ListBoardsResponse](ctx, s.client, req, http.StatusOK)
 }
 
-func (b *Board) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
+// ListTeamBoards lists boards of the team by Team ID.
+//
+// API doc: https://developers.miro.com/reference#get-team-boards
+func (s *BoardsService) ListTeamBoards(ctx context.Context, teamID string, opt *ListOptions) (*ListBoardsResponse, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.ListTeamBoards")
 
-	err := json.Unmarshal(j, &rawStrings)
+	req, err := s.client.NewGetRequest(addListOptions(fmt.Sprintf("%s/%s/boards", teamsPath, teamID), opt))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			b.ID = v.(string)
-		}
-
-		if strings.ToLower(k) == "name" {
-			b.Name = v.(string)
-		}
-
-		if strings.ToLower(k) == "description" {
-			b.Description = v.(string)
-		}
-
-		if strings.ToLower(k) == "viewlink" {
-			b.ViewLink = v.(string)
-		}
-
-		if strings.ToLower(k) == "createdAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			b.CreatedAt = at
-		}
-
-		if strings.ToLower(k) == "modifiedAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			b.ModifiedAt = at
-		}
-
-		if strings.ToLower(k) == "owner" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
+	return do[ListBoardsResponse](ctx, s.client, req, http.StatusOK)
+}
 
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
+// ListAllTeamBoards lists every board of the team by following the pagination.
+func (s *BoardsService) ListAllTeamBoards(ctx context.Context, teamID string) ([]*Board, *Response, error) {
+	ctx = withMethod(ctx, "BoardsService.ListAllTeamBoards")
 
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
+	opt := &ListOptions{}
+	boards := []*Board{}
 
-			b.Owner = user
+	for {
+		page, resp, err := s.ListTeamBoards(ctx, teamID, opt)
+		if err != nil {
+			return nil, resp, err
 		}
 
-		if strings.ToLower(k) == "picture" {
-			if v == nil {
-				b.Picture = nil
-			} else {
-				pic := &MiniPicture{}
-				p := v.(map[string]interface{})
-
-				for k, v := range p {
-					if strings.ToLower(k) == "id" {
-						pic.ID = v.(string)
-					}
-
-					if strings.ToLower(k) == "imageurl" {
-						pic.ImageURL = v.(string)
-					}
-				}
+		boards = append(boards, page.Data...)
+		opt.Offset += len(page.Data)
 
-				b.Picture = pic
-			}
+		if len(page.Data) == 0 || opt.Offset >= page.Size {
+			return boards, resp, nil
 		}
+	}
+}
 
-		if strings.ToLower(k) == "createdBy" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
+// UnmarshalJSON decodes the board keeping the members unknown to this package in Extra.
+func (b *Board) UnmarshalJSON(data []byte) error {
+	type alias Board
+	extra, err := unmarshalExtra(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
 
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
+	b.Extra = extra
+	return nil
+}
 
-			b.CreatedBy = user
-		}
+// MarshalJSON encodes the board with the members in Extra.
+func (b Board) MarshalJSON() ([]byte, error) {
+	type alias Board
+	return marshalExtra(alias(b), b.Extra)
+}
 
-		if strings.ToLower(k) == "modifiedBy" {
-			user := &MiniUser{}
-			u := v.(map[string]string)
+// UnmarshalJSON decodes the sharing policy keeping the members unknown to this package in Extra.
+func (p *SharingPolicy) UnmarshalJSON(data []byte) error {
+	type alias SharingPolicy
+	extra, err := unmarshalExtra(data, (*alias)(p))
+	if err != nil {
+		return err
+	}
 
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v
-				}
+	p.Extra = extra
+	return nil
+}
 
-				if strings.ToLower(k) == "name" {
-					user.Name = v
-				}
-			}
+// MarshalJSON encodes the sharing policy with the members in Extra.
+func (p SharingPolicy) MarshalJSON() ([]byte, error) {
+	type alias SharingPolicy
+	return marshalExtra(alias(p), p.Extra)
+}
 
-			b.ModifiedBy = user
-		}
+// UnmarshalJSON decodes the mini board keeping the members unknown to this package in Extra.
+func (b *MiniBoard) UnmarshalJSON(data []byte) error {
+	type alias MiniBoard
+	extra, err := unmarshalExtra(data, (*alias)(b))
+	if err != nil {
+		return err
 	}
 
+	b.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the mini board with the members in Extra.
+func (b MiniBoard) MarshalJSON() ([]byte, error) {
+	type alias MiniBoard
+	return marshalExtra(alias(b), b.Extra)
+}
@@ -2,6 +2,8 @@ package miro
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -23,6 +25,30 @@ func getBoardJSON(id string) string {
 	"viewLink": "%s",
 	"description": "%s",
 	"picture": null,
+	"sharingPolicy": {
+		"access": "view",
+		"teamAccess": "edit"
+	},
+	"owner": {
+		"type": "user",
+		"id": "owner",
+		"name": "Owner"
+	},
+	"createdBy": {
+		"type": "user",
+		"id": "owner",
+		"name": "Owner"
+	},
+	"modifiedBy": {
+		"type": "user",
+		"id": "editor",
+		"name": "Editor"
+	},
+	"currentUserConnection": {
+		"type": "board-user-connection",
+		"id": "connection",
+		"role": "owner"
+	},
 	"createdAt": "1995-06-15T10:00:00Z",
 	"modifiedAt": "1995-06-15T10:00:00Z"
 }`, id, testBoardName, testBoardViewLink, testBoardDesc)
@@ -37,8 +63,8 @@ func getBoardListJSON() string {
 }
 
 func getBoard(id string) *Board {
-	modifiedAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
-	createdAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
+	modifiedAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
+	createdAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
 
 	return &Board{
 		ID:         id,
@@ -46,6 +72,18 @@ func getBoard(id string) *Board {
 		Name:       testBoardName,
 		ModifiedAt: modifiedAt,
 		CreatedAt:  createdAt,
+		SharingPolicy: &SharingPolicy{
+			Access:     AccessLevelView,
+			TeamAccess: AccessLevelEdit,
+		},
+		Owner:      &MiniUser{ID: "owner", Name: "Owner", Extra: extra("type", `"user"`)},
+		CreatedBy:  &MiniUser{ID: "owner", Name: "Owner", Extra: extra("type", `"user"`)},
+		ModifiedBy: &MiniUser{ID: "editor", Name: "Editor", Extra: extra("type", `"user"`)},
+		CurrentUserConnection: &BoardUserConnection{
+			ID:    "connection",
+			Role:  BoardRoleOwner,
+			Extra: extra("type", `"board-user-connection"`),
+		},
 	}
 }
 
@@ -72,7 +110,7 @@ func TestBoardsService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getBoardJSON(tc.id)))
 			})
 
-			got, err := client.Boards.Get(context.Background(), tc.id)
+			got, _, err := client.Boards.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -102,7 +140,7 @@ func TestBoardsService_Share(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getBoardListJSON()))
 			})
 
-			got, err := client.Boards.Share(context.Background(), tc.id, &ShareBoardRequest{
+			got, _, err := client.Boards.Share(context.Background(), tc.id, &ShareBoardRequest{
 				tc.emails,
 			})
 
@@ -135,7 +173,7 @@ func TestBoardsService_Get_Error(t *testing.T) {
 				http.Error(w, fmt.Sprintf(getErrorJSON(http.StatusNotFound)), http.StatusNotFound)
 			})
 
-			_, err := client.Boards.Get(context.Background(), tc.id)
+			_, _, err := client.Boards.Get(context.Background(), tc.id)
 			if err == nil {
 				t.Fatalf("Should failed")
 			}
@@ -146,3 +184,106 @@ func TestBoardsService_Get_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardsService_ShareWithRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id      string
+		req     *ShareBoardWithRolesRequest
+		want    map[BoardRole][]string
+		wantErr bool
+	}{
+		"ok": {"1", &ShareBoardWithRolesRequest{
+			Invitations: []*BoardInvitation{
+				{Email: "keke@miro.com", Role: BoardRoleEditor},
+				{Email: "miro@keke.com", Role: BoardRoleViewer},
+				{Email: "test@keke.com", Role: BoardRoleEditor},
+			},
+			Message: "welcome",
+		}, map[BoardRole][]string{
+			BoardRoleEditor: {"keke@miro.com", "test@keke.com"},
+			BoardRoleViewer: {"miro@keke.com"},
+		}, false},
+		"owner": {"2", &ShareBoardWithRolesRequest{
+			Invitations: []*BoardInvitation{
+				{Email: "keke@miro.com", Role: BoardRoleOwner},
+			},
+		}, map[BoardRole][]string{}, true},
+		"no role": {"3", &ShareBoardWithRolesRequest{
+			Invitations: []*BoardInvitation{
+				{Email: "keke@miro.com", Role: BoardRoleViewer},
+				{Email: "miro@keke.com"},
+			},
+		}, map[BoardRole][]string{}, true},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := map[BoardRole][]string{}
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/share", boardsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				req := &shareBoardRoleRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if req.Message != tc.req.Message {
+					t.Fatalf("message not expected, got:%s", req.Message)
+				}
+				got[req.Role] = req.Emails
+				fmt.Fprint(w, `{"data": []}`)
+			})
+
+			_, _, err := client.Boards.ShareWithRoles(context.Background(), tc.id, tc.req)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("error not expected, got:%v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+
+	t.Run("failed", func(t *testing.T) {
+		mux.HandleFunc(fmt.Sprintf("/%s/4/share", boardsPath), func(w http.ResponseWriter, r *http.Request) {
+			req := &shareBoardRoleRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if req.Role == BoardRoleEditor {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"data": [{"id": "%s", "role": "%s"}]}`, req.Emails[0], req.Role)
+		})
+
+		got, _, err := client.Boards.ShareWithRoles(context.Background(), "4", &ShareBoardWithRolesRequest{
+			Invitations: []*BoardInvitation{
+				{Email: "keke@miro.com", Role: BoardRoleViewer},
+				{Email: "miro@keke.com", Role: BoardRoleEditor},
+				{Email: "test@keke.com", Role: BoardRoleCommenter},
+			},
+		})
+
+		var shareErr *InviteError
+		if !errors.As(err, &shareErr) {
+			t.Fatalf("Should failed")
+		}
+
+		if diff := cmp.Diff([][]string{shareErr.Invited, shareErr.Failed}, [][]string{{"keke@miro.com", "test@keke.com"}, {"miro@keke.com"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		ids := []string{}
+		for _, c := range got {
+			ids = append(ids, c.ID)
+		}
+
+		if diff := cmp.Diff(ids, []string{"keke@miro.com", "test@keke.com"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package miro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBulkConcurrency = 4
+	maxBulkAttempts        = 3
+)
+
+// BulkOptions specifies the optional parameters of the bulk operations on widgets.
+type BulkOptions struct {
+	// Concurrency is the number of requests sent at the same time. Zero uses 4.
+	Concurrency int
+}
+
+// BulkResult represents the result of the operation on a widget of a bulk operation.
+type BulkResult struct {
+	// Index is the index of the widget in the input of the bulk operation.
+	Index int
+
+	// WidgetID is the ID of the widget, empty when a creation failed.
+	WidgetID string
+
+	// Widget is the widget returned by Miro, nil on failure and for deletions.
+	Widget Widget
+
+	Response *Response
+	Err      error
+}
+
+// BulkResults represents the results of a bulk operation in the order of its input.
+type BulkResults []*BulkResult
+
+// Failed returns the results with an error, to retry only the failures.
+func (r BulkResults) Failed() BulkResults {
+	failed := BulkResults{}
+	for _, res := range r {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+
+	return failed
+}
+
+// Err returns *BulkError when an operation failed, nil otherwise.
+func (r BulkResults) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return &BulkError{Failed: failed, Total: len(r)}
+}
+
+// BulkError represents the failures of a bulk operation.
+type BulkError struct {
+	Failed BulkResults
+	Total  int
+}
+
+func (e *BulkError) Error() string {
+	return fmt.Sprintf("%d of %d operations failed, first error:%v", len(e.Failed), e.Total, e.Failed[0].Err)
+}
+
+// Unwrap returns the error of the first failure.
+func (e *BulkError) Unwrap() error {
+	return e.Failed[0].Err
+}
+
+// CreateMany creates the widgets on the board with a bounded number of requests at the same time.
+// The API v1 has no batch endpoint, so every widget is a request waiting for the rate limit of the client.
+// The returned error is *BulkError when a creation failed, the results hold the widgets created anyway.
+func (s *WidgetsService) CreateMany(ctx context.Context, boardID string, widgets []Widget, opt *BulkOptions) (BulkResults, error) {
+	ctx = withMethod(ctx, "WidgetsService.CreateMany")
+
+	return s.bulk(ctx, len(widgets), opt, func(ctx context.Context, i int) (string, Widget, *Response, error) {
+		w, resp, err := s.Create(ctx, boardID, widgets[i])
+		if err != nil {
+			return "", nil, resp, err
+		}
+
+		return w.Base().ID, w, resp, nil
+	})
+}
+
+// UpdateMany updates the widgets on the board by the IDs of the widgets, like CreateMany.
+func (s *WidgetsService) UpdateMany(ctx context.Context, boardID string, widgets []Widget, opt *BulkOptions) (BulkResults, error) {
+	ctx = withMethod(ctx, "WidgetsService.UpdateMany")
+
+	return s.bulk(ctx, len(widgets), opt, func(ctx context.Context, i int) (string, Widget, *Response, error) {
+		id := widgets[i].Base().ID
+		w, resp, err := s.Update(ctx, boardID, id, widgets[i])
+		return id, w, resp, err
+	})
+}
+
+// DeleteMany deletes the widgets on the board by Widget ID, like CreateMany.
+func (s *WidgetsService) DeleteMany(ctx context.Context, boardID string, widgetIDs []string, opt *BulkOptions) (BulkResults, error) {
+	ctx = withMethod(ctx, "WidgetsService.DeleteMany")
+
+	return s.bulk(ctx, len(widgetIDs), opt, func(ctx context.Context, i int) (string, Widget, *Response, error) {
+		resp, err := s.Delete(ctx, boardID, widgetIDs[i])
+		return widgetIDs[i], nil, resp, err
+	})
+}
+
+// bulk calls fn for the indices up to n from a pool of workers and collects the results.
+func (s *WidgetsService) bulk(ctx context.Context, n int, opt *BulkOptions,
+	fn func(ctx context.Context, i int) (string, Widget, *Response, error)) (BulkResults, error) {
+	concurrency := defaultBulkConcurrency
+	if opt != nil && opt.Concurrency > 0 {
+		concurrency = opt.Concurrency
+	}
+
+	results := make(BulkResults, n)
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				res := &BulkResult{Index: i}
+				res.Err = s.client.withRateLimit(ctx, func() (*Response, error) {
+					res.WidgetID, res.Widget, res.Response, res.Err = fn(ctx, i)
+					return res.Response, res.Err
+				})
+				results[i] = res
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results, results.Err()
+}
+
+// withRateLimit calls fn once the rate limit of the client allows it.
+// Requests rejected with 429 Too Many Requests are retried after the reset of the rate limit.
+func (c *Client) withRateLimit(ctx context.Context, fn func() (*Response, error)) error {
+	var err error
+	for attempt := 0; attempt < maxBulkAttempts; attempt++ {
+		if err := c.waitRateLimit(ctx); err != nil {
+			return err
+		}
+
+		var resp *Response
+		resp, err = fn()
+
+		var respErr *RespError
+		if !errors.As(err, &respErr) || respErr.Status != http.StatusTooManyRequests ||
+			resp == nil || resp.RateLimit.Reset.IsZero() {
+			return err
+		}
+	}
+
+	return err
+}
+
+// waitRateLimit waits for the reset of the rate limit when no request remains.
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	c.mu.RLock()
+	rate := *c.RateLimit
+	c.mu.RUnlock()
+
+	d := time.Until(rate.Reset)
+	if rate.Remaining > 0 || d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWidgetsService_CreateMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	next := 0
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		s := &Sticker{}
+		if err := json.NewDecoder(r.Body).Decode(s); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if s.Text == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status": 400, "message": "invalid"}`)
+			return
+		}
+
+		mu.Lock()
+		next++
+		id := strconv.Itoa(next)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, getStickerJSON(id))
+	})
+
+	widgets := []Widget{
+		&Sticker{Text: "a"},
+		&Sticker{Text: "fail"},
+		&Sticker{Text: "b"},
+		&Sticker{Text: "c"},
+	}
+
+	got, err := client.Widgets.CreateMany(context.Background(), "board", widgets, &BulkOptions{Concurrency: 2})
+	if err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || bulkErr.Total != 4 || len(bulkErr.Failed) != 1 {
+		t.Fatalf("error not expected, got:%v", err)
+	}
+
+	if diff := cmp.Diff(err.Error(), "1 of 4 operations failed, first error:status code not expected, got:400, message:invalid"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	for i, res := range got {
+		if res.Index != i {
+			t.Fatalf("index not expected, got:%d want:%d", res.Index, i)
+		}
+
+		if (res.Err != nil) != (i == 1) || (res.WidgetID == "") != (i == 1) {
+			t.Fatalf("result %d not expected, got:%+v", i, res)
+		}
+	}
+
+	if diff := cmp.Diff(got.Failed()[0].Index, 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWidgetsService_DeleteMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		mu.Lock()
+		deleted[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	got, err := client.Widgets.DeleteMany(context.Background(), "board", []string{"1", "2", "3"}, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(deleted, map[string]bool{"1": true, "2": true, "3": true}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	ids := []string{}
+	for _, res := range got {
+		ids = append(ids, res.WidgetID)
+	}
+
+	if diff := cmp.Diff(ids, []string{"1", "2", "3"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWidgetsService_UpdateMany_RateLimited(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set(rateLimitLimitHeader, "10")
+			w.Header().Set(rateLimitRemainingHeader, "0")
+			w.Header().Set(rateLimitResetHeader, strconv.FormatInt(time.Now().Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		fmt.Fprint(w, getStickerJSON("1"))
+	})
+
+	got, err := client.Widgets.UpdateMany(context.Background(), "board", []Widget{getSticker("1")}, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(calls, 2); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(got[0].Widget, Widget(getSticker("1"))); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestClient_waitRateLimit(t *testing.T) {
+	client := NewClient(testAccessKey)
+	client.RateLimit = &RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := client.waitRateLimit(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error not expected, got:%v", err)
+	}
+
+	client.RateLimit.Remaining = 1
+	if err := client.waitRateLimit(context.Background()); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
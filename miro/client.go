@@ -9,12 +9,13 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
 var (
-	defaultRateLimit = &RateLimit{
+	defaultRateLimit = RateLimit{
 		Limit:     10000,
 		Remaining: 10000,
 	}
@@ -24,6 +25,7 @@ const (
 	rateLimitResetHeader     = "X-RateLimit-Reset"
 	rateLimitRemainingHeader = "X-RateLimit-Remaining"
 	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	requestIDHeader          = "X-Request-Id"
 )
 
 type Client struct {
@@ -33,19 +35,41 @@ type Client struct {
 
 	mu sync.RWMutex
 
+	// tokenMu guards tokenInfo, the authorization info of the access token cached by TokenInfo,
+	// and tokenCall, the request of the info in flight.
+	tokenMu   sync.Mutex
+	tokenInfo *AuthorizationInfo
+	tokenCall *tokenCall
+
 	RateLimit   *RateLimit
 	UserAgent   string
 	AccessToken string
 	BaseURL     *url.URL
 
-	AuditLogs           *AuditLogsService
-	AuthzInfo           *AuthzInfoService
-	Boards              *BoardsService
-	BoardUserConnection *BoardUserConnectionService
-	Picture             *PicturesService
-	Teams               *TeamsService
-	TeamUserConnection  *TeamUserConnectionService
-	Users               *UsersService
+	// KeepRawResponse makes the responses hold the raw response body in Response.Raw.
+	KeepRawResponse bool
+
+	// FailOnMissingScope makes the service methods return *MissingScopeError before sending any request
+	// when the access token lacks a scope they require. The token info is fetched once by the first check.
+	// The methods RequiredScopes does not know, calling undocumented endpoints, are not checked.
+	FailOnMissingScope bool
+
+	// The services are held as interfaces so that they can be replaced, by the mocks of the mock package in tests for example.
+	AuditLogs           AuditLogsAPI
+	AuthzInfo           AuthzInfoAPI
+	Boards              BoardsAPI
+	BoardUserConnection BoardUserConnectionAPI
+	Comments            CommentsAPI
+	Organizations       OrganizationsAPI
+	Picture             PicturesAPI
+	Tags                TagsAPI
+	Teams               TeamsAPI
+	TeamUserConnection  TeamUserConnectionAPI
+	Users               UsersAPI
+	Widgets             WidgetsAPI
+
+	// V2 holds the services of the API v2.
+	V2 *V2
 }
 
 type RateLimit struct {
@@ -67,23 +91,41 @@ func NewClient(accessKey string) *Client {
 
 	c.common.client = c
 	c.client = http.DefaultClient
-	c.RateLimit = defaultRateLimit
+	rate := defaultRateLimit
+	c.RateLimit = &rate
 
 	c.AuditLogs = (*AuditLogsService)(&c.common)
 	c.AuthzInfo = (*AuthzInfoService)(&c.common)
 	c.Boards = (*BoardsService)(&c.common)
 	c.BoardUserConnection = (*BoardUserConnectionService)(&c.common)
+	c.Comments = (*CommentsService)(&c.common)
+	c.Organizations = (*OrganizationsService)(&c.common)
 	c.Picture = (*PicturesService)(&c.common)
+	c.Tags = (*TagsService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.TeamUserConnection = (*TeamUserConnectionService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
+	c.Widgets = (*WidgetsService)(&c.common)
+
+	c.V2 = &V2{
+		Boards:       (*BoardsV2Service)(&c.common),
+		Items:        (*ItemsV2Service)(&c.common),
+		Connectors:   (*ConnectorsV2Service)(&c.common),
+		Tags:         (*TagsV2Service)(&c.common),
+		BoardMembers: (*BoardMembersV2Service)(&c.common),
+	}
 
 	return c
 }
 
-// NewRequest creates an API request.
+// NewRequest creates an API v1 request.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	u, err := c.BaseURL.Parse(fmt.Sprintf("v1/%s", urlStr))
+	return c.NewRequestWithVersion(APIVersion1, method, urlStr, body)
+}
+
+// NewRequestWithVersion creates an API request for the API version.
+func (c *Client) NewRequestWithVersion(version APIVersion, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(fmt.Sprintf("%s/%s", version, urlStr))
 	if err != nil {
 		return nil, err
 	}
@@ -137,43 +179,128 @@ func (c *Client) NewDeleteRequest(urlStr string) (*http.Request, error) {
 	return c.NewRequest("DELETE", urlStr, nil)
 }
 
+// Do sends an API request and returns the API response.
+// The rate limit of the client is updated from the response headers.
+// It is the responsibility of the caller to close the response body.
 func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	if l := resp.Header.Get(rateLimitLimitHeader); l != "" {
-		c.mu.Lock()
-		limit, err := strconv.Atoi(l)
-		if err != nil {
-			return nil, err
-		}
-		c.RateLimit.Limit = limit
-		c.mu.Unlock()
+	c.mu.Lock()
+	parseRateLimit(resp, c.RateLimit)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// Response represents a Miro API response with its metadata.
+type Response struct {
+	*http.Response
+
+	// RateLimit is the rate limit returned with the response, the values not returned being zero.
+	RateLimit RateLimit
+
+	// RequestID is the ID of the request assigned by Miro.
+	RequestID string
+
+	// Raw is the response body, only set when the client keeps raw responses.
+	Raw []byte
+}
+
+func newResponse(r *http.Response) *Response {
+	resp := &Response{
+		Response:  r,
+		RequestID: r.Header.Get(requestIDHeader),
+	}
+
+	parseRateLimit(r, &resp.RateLimit)
+
+	return resp
+}
+
+// parseRateLimit updates rate from the rate limit headers of the response.
+// Each header is parsed on its own, a missing or invalid header keeps the previous value.
+func parseRateLimit(r *http.Response, rate *RateLimit) {
+	if limit, err := strconv.Atoi(r.Header.Get(rateLimitLimitHeader)); err == nil {
+		rate.Limit = limit
+	}
+
+	if remaining, err := strconv.Atoi(r.Header.Get(rateLimitRemainingHeader)); err == nil {
+		rate.Remaining = remaining
+	}
+
+	if reset, err := strconv.ParseInt(r.Header.Get(rateLimitResetHeader), 10, 64); err == nil {
+		rate.Reset = time.Unix(reset, 0)
 	}
+}
 
-	if r := resp.Header.Get(rateLimitRemainingHeader); r != "" {
-		c.mu.Lock()
-		remaining, err := strconv.Atoi(r)
+// do sends the API request and decodes the response body into a new T.
+// A status code not in expected is returned as *RespError.
+// The response body is always closed, an empty body results in the zero value of T.
+// When the client fails on missing scopes, the scopes of the service method carried by ctx are checked first.
+func do[T any](ctx context.Context, c *Client, req *http.Request, expected ...int) (*T, *Response, error) {
+	if err := c.checkMethodScopes(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	r, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := r.Body
+	defer func() {
+		io.Copy(io.Discard, body)
+		body.Close()
+	}()
+
+	resp := newResponse(r)
+
+	if c.KeepRawResponse {
+		raw, err := io.ReadAll(body)
 		if err != nil {
-			return nil, err
+			return nil, resp, err
 		}
-		c.RateLimit.Remaining = remaining
-		c.mu.Unlock()
+
+		resp.Raw = raw
+		r.Body = io.NopCloser(bytes.NewReader(raw))
 	}
 
-	if r := resp.Header.Get(rateLimitResetHeader); r != "" {
-		c.mu.Lock()
-		r, err := strconv.Atoi(r)
-		if err != nil {
-			return nil, err
+	if !isExpectedStatus(r.StatusCode, expected) {
+		return nil, resp, newRespError(r)
+	}
+
+	v := new(T)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+func isExpectedStatus(code int, expected []int) bool {
+	for _, e := range expected {
+		if code == e {
+			return true
 		}
-		c.RateLimit.Reset = time.Unix(int64(r), 0)
-		c.mu.Unlock()
 	}
 
-	return resp, nil
+	return false
+}
+
+// newRespError reads the error returned by Miro from the response.
+// Bodies that are not JSON are used as the error message.
+func newRespError(r *http.Response) *RespError {
+	respErr := &RespError{}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil || json.Unmarshal(body, respErr) != nil {
+		respErr = &RespError{Message: strings.TrimSpace(string(body))}
+	}
+
+	respErr.Status = r.StatusCode
+	return respErr
 }
 
 // RespError represents error response from Miro
@@ -189,5 +316,5 @@ type RespError struct {
 }
 
 func (e *RespError) Error() string {
-	return e.Message
+	return fmt.Sprintf("status code not expected, got:%d, message:%s", e.Status, e.Message)
 }
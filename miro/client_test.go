@@ -1,8 +1,17 @@
 package miro
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func getErrorJSON(status int) string {
@@ -13,8 +22,237 @@ func getErrorJSON(status int) string {
 }`, status)
 }
 
+// extra returns the Extra of a model from pairs of member names and raw JSON values.
+func extra(kv ...string) map[string]json.RawMessage {
+	m := map[string]json.RawMessage{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		m[kv[i]] = json.RawMessage(kv[i+1])
+	}
+
+	return m
+}
+
 func addHeader(w http.ResponseWriter) {
 	w.Header().Add(rateLimitRemainingHeader, "99")
 	w.Header().Add(rateLimitLimitHeader, "1000")
 	w.Header().Add(rateLimitResetHeader, "1598795193")
 }
+
+// closeRecorder records whether the response body was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestDo_Response(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/1", usersPath), func(w http.ResponseWriter, r *http.Request) {
+		addHeader(w)
+		w.Header().Set(requestIDHeader, "request")
+		fmt.Fprint(w, getUserJSON("1"))
+	})
+
+	_, resp, err := client.Users.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := RateLimit{
+		Limit:     1000,
+		Remaining: 99,
+		Reset:     time.Unix(1598795193, 0),
+	}
+
+	if diff := cmp.Diff(resp.RateLimit, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(*client.RateLimit, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if resp.RequestID != "request" {
+		t.Fatalf("request ID not expected, got:%s", resp.RequestID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status code not expected, got:%d", resp.StatusCode)
+	}
+}
+
+func TestDo_PartialRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/1", usersPath), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitRemainingHeader, "42")
+		w.Header().Set(rateLimitLimitHeader, "invalid")
+		fmt.Fprint(w, getUserJSON("1"))
+	})
+
+	_, resp, err := client.Users.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(resp.RateLimit, RateLimit{Remaining: 42}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	want := defaultRateLimit
+	want.Remaining = 42
+	if diff := cmp.Diff(*client.RateLimit, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDo_ClosesBody(t *testing.T) {
+	tcs := map[string]struct {
+		status int
+		body   string
+	}{
+		"ok":    {http.StatusOK, getUserJSON("1")},
+		"error": {http.StatusNotFound, getErrorJSON(http.StatusNotFound)},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			body := &closeRecorder{Reader: strings.NewReader(tc.body)}
+			client := NewClient(testAccessKey)
+			client.client = &http.Client{
+				Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: tc.status,
+						Header:     http.Header{},
+						Body:       body,
+					}, nil
+				}),
+			}
+
+			client.Users.Get(context.Background(), "1")
+			if !body.closed {
+				t.Fatalf("body not closed")
+			}
+		})
+	}
+}
+
+func TestDo_Error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id   string
+		body string
+		want *RespError
+	}{
+		"json":  {"1", getErrorJSON(http.StatusBadRequest), &RespError{Status: http.StatusBadRequest, Message: "error", Type: "error"}},
+		"plain": {"2", "bad request", &RespError{Status: http.StatusBadRequest, Message: "bad request"}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", boardsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, tc.body, http.StatusBadRequest)
+			})
+
+			_, err := client.Boards.Delete(context.Background(), tc.id)
+			if diff := cmp.Diff(err, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestDo_TransportError(t *testing.T) {
+	client := NewClient(testAccessKey)
+	client.client = &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}),
+	}
+
+	resp, err := client.Boards.Delete(context.Background(), "1")
+	if err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if resp != nil {
+		t.Fatalf("response not expected, got:%v", resp)
+	}
+}
+
+func TestDo_KeepRawResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/1", usersPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getUserJSON("1"))
+	})
+
+	tcs := map[string]struct {
+		keep bool
+		want []byte
+	}{
+		"keep":    {true, []byte(getUserJSON("1"))},
+		"discard": {false, nil},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client.KeepRawResponse = tc.keep
+
+			got, resp, err := client.Users.Get(context.Background(), "1")
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(resp.Raw, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(got, getUser("1")); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestClient_NewRequestWithVersion(t *testing.T) {
+	client := NewClient(testAccessKey)
+
+	tcs := map[string]struct {
+		version APIVersion
+		path    string
+		want    string
+	}{
+		"v1": {APIVersion1, "boards/1", "https://api.miro.com/v1/boards/1"},
+		"v2": {APIVersion2, "boards/1/items?limit=10", "https://api.miro.com/v2/boards/1/items?limit=10"},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			req, err := client.NewRequestWithVersion(tc.version, http.MethodGet, tc.path, nil)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(req.URL.String(), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
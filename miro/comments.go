@@ -0,0 +1,197 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	commentsPath = "comments"
+	repliesPath  = "replies"
+)
+
+// CommentsService handles communication to Miro Comments API.
+//
+// API doc: No document yet
+type CommentsService service
+
+// CommentThread object represents Miro comment thread, the comments on a point of the board or on a widget.
+// WidgetID is the ID of the widget the thread is anchored to, empty for the threads on the board itself.
+//
+// API doc: No document yet
+//
+//go:generate gomodifytags -file $GOFILE -struct CommentThread -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct CommentThread -add-tags json -w -transform camelcase
+type CommentThread struct {
+	ID         string     `json:"id"`
+	WidgetID   string     `json:"widgetId,omitempty"`
+	Position   *Point     `json:"position,omitempty"`
+	Resolved   bool       `json:"resolved"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	ResolvedBy *MiniUser  `json:"resolvedBy,omitempty"`
+	Comments   []*Comment `json:"comments"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	CreatedBy  *MiniUser  `json:"createdBy"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Comment object represents Miro comment, the first one starting its thread and the others replying to it.
+// Text is formatted as HTML like the text of widgets.
+//
+// API doc: No document yet
+//
+//go:generate gomodifytags -file $GOFILE -struct Comment -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Comment -add-tags json -w -transform camelcase
+type Comment struct {
+	ID         string    `json:"id"`
+	Text       string    `json:"text"`
+	CreatedAt  time.Time `json:"createdAt"`
+	CreatedBy  *MiniUser `json:"createdBy"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// listCommentThreadsResponse represents list comment threads response from Miro.
+type listCommentThreadsResponse struct {
+	Type string           `json:"type"`
+	Data []*CommentThread `json:"data"`
+}
+
+// replyRequest represents reply request payload.
+type replyRequest struct {
+	Text string `json:"text"`
+}
+
+// resolveRequest represents resolve and reopen request payload.
+type resolveRequest struct {
+	Resolved bool `json:"resolved"`
+}
+
+// List lists the comment threads on the board by Board ID, resolved or not.
+//
+// API doc: No document yet
+func (s *CommentsService) List(ctx context.Context, boardID string) ([]*CommentThread, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.List")
+
+	return s.list(ctx, fmt.Sprintf("%s/%s/%s", boardsPath, boardID, commentsPath))
+}
+
+// ListByWidget lists the comment threads anchored to the widget by Board ID and Widget ID.
+//
+// API doc: No document yet
+func (s *CommentsService) ListByWidget(ctx context.Context, boardID, widgetID string) ([]*CommentThread, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.ListByWidget")
+
+	return s.list(ctx, addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, commentsPath), url.Values{"widgetId": {widgetID}}))
+}
+
+func (s *CommentsService) list(ctx context.Context, path string) ([]*CommentThread, *Response, error) {
+	req, err := s.client.NewGetRequest(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, resp, err := do[listCommentThreadsResponse](ctx, s.client, req, http.StatusOK)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return list.Data, resp, nil
+}
+
+// Get gets the comment thread with its comments by Board ID and Thread ID.
+//
+// API doc: No document yet
+func (s *CommentsService) Get(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.Get")
+
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, commentsPath, threadID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[CommentThread](ctx, s.client, req, http.StatusOK)
+}
+
+// Reply posts the comment to the thread by Board ID and Thread ID, as the user of the access token.
+//
+// API doc: No document yet
+func (s *CommentsService) Reply(ctx context.Context, boardID, threadID, text string) (*Comment, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.Reply")
+
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s/%s/%s", boardsPath, boardID, commentsPath, threadID, repliesPath), &replyRequest{Text: text})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Comment](ctx, s.client, req, http.StatusOK, http.StatusCreated)
+}
+
+// Resolve resolves the thread by Board ID and Thread ID.
+//
+// API doc: No document yet
+func (s *CommentsService) Resolve(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.Resolve")
+
+	return s.resolve(ctx, boardID, threadID, true)
+}
+
+// Reopen reopens the resolved thread by Board ID and Thread ID.
+//
+// API doc: No document yet
+func (s *CommentsService) Reopen(ctx context.Context, boardID, threadID string) (*CommentThread, *Response, error) {
+	ctx = withMethod(ctx, "CommentsService.Reopen")
+
+	return s.resolve(ctx, boardID, threadID, false)
+}
+
+func (s *CommentsService) resolve(ctx context.Context, boardID, threadID string, resolved bool) (*CommentThread, *Response, error) {
+	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, commentsPath, threadID), &resolveRequest{Resolved: resolved})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[CommentThread](ctx, s.client, req, http.StatusOK)
+}
+
+// UnmarshalJSON decodes the comment thread keeping the members unknown to this package in Extra.
+func (t *CommentThread) UnmarshalJSON(data []byte) error {
+	type alias CommentThread
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the comment thread with the members in Extra.
+func (t CommentThread) MarshalJSON() ([]byte, error) {
+	type alias CommentThread
+	return marshalExtra(alias(t), t.Extra)
+}
+
+// UnmarshalJSON decodes the comment keeping the members unknown to this package in Extra.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type alias Comment
+	extra, err := unmarshalExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the comment with the members in Extra.
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type alias Comment
+	return marshalExtra(alias(c), c.Extra)
+}
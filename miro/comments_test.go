@@ -0,0 +1,187 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getCommentThreadJSON(id, widgetID string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"widgetId": "%s",
+	"resolved": false,
+	"comments": [{
+		"id": "%s-1",
+		"text": "<p>Why red?</p>",
+		"createdAt": "1995-06-15T10:00:00Z",
+		"createdBy": {"id": "user", "name": "Sergey"},
+		"modifiedAt": "1995-06-15T10:00:00Z"
+	}],
+	"createdAt": "1995-06-15T10:00:00Z",
+	"createdBy": {"id": "user", "name": "Sergey"}
+}`, id, widgetID, id)
+}
+
+func getCommentThread(id, widgetID string) *CommentThread {
+	at := time.Date(1995, 6, 15, 10, 0, 0, 0, time.UTC)
+	user := &MiniUser{ID: "user", Name: "Sergey"}
+
+	return &CommentThread{
+		ID:        id,
+		WidgetID:  widgetID,
+		Comments:  []*Comment{{ID: id + "-1", Text: "<p>Why red?</p>", CreatedAt: at, CreatedBy: user, ModifiedAt: at}},
+		CreatedAt: at,
+		CreatedBy: user,
+	}
+}
+
+func TestCommentsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, commentsPath), func(w http.ResponseWriter, r *http.Request) {
+		if id := r.URL.Query().Get("widgetId"); id != "" {
+			fmt.Fprintf(w, `{"type": "collection", "data": [%s]}`, getCommentThreadJSON("2", id))
+			return
+		}
+
+		fmt.Fprintf(w, `{"type": "collection", "data": [%s, %s]}`, getCommentThreadJSON("1", "w1"), getCommentThreadJSON("2", "w2"))
+	})
+
+	tcs := map[string]struct {
+		widgetID string
+		want     []*CommentThread
+	}{
+		"board":  {"", []*CommentThread{getCommentThread("1", "w1"), getCommentThread("2", "w2")}},
+		"widget": {"w2", []*CommentThread{getCommentThread("2", "w2")}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			var got []*CommentThread
+			var err error
+			if tc.widgetID == "" {
+				got, _, err = client.Comments.List(context.Background(), "board")
+			} else {
+				got, _, err = client.Comments.ListByWidget(context.Background(), "board", tc.widgetID)
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestCommentsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, commentsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getCommentThreadJSON("1", "w1"))
+	})
+
+	got, _, err := client.Comments.Get(context.Background(), "board", "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, getCommentThread("1", "w1")); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestCommentsService_Reply(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var got string
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1/%s", boardsPath, commentsPath, repliesPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		got = string(body["text"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "1-2", "text": "Brand color", "createdBy": {"id": "user"}}`)
+	})
+
+	c, _, err := client.Comments.Reply(context.Background(), "board", "1", "Brand color")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, `"Brand color"`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(c, &Comment{ID: "1-2", Text: "Brand color", CreatedBy: &MiniUser{ID: "user"}}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestCommentsService_Resolve(t *testing.T) {
+	tcs := map[string]struct {
+		resolve bool
+		want    string
+	}{
+		"resolve": {true, `{"resolved":true}`},
+		"reopen":  {false, `{"resolved":false}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var got string
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, commentsPath), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				var body map[string]json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				b, _ := json.Marshal(body)
+				got = string(b)
+
+				fmt.Fprintf(w, `{"id": "1", "resolved": %s}`, body["resolved"])
+			})
+
+			var thread *CommentThread
+			var err error
+			if tc.resolve {
+				thread, _, err = client.Comments.Resolve(context.Background(), "board", "1")
+			} else {
+				thread, _, err = client.Comments.Reopen(context.Background(), "board", "1")
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if thread.Resolved != tc.resolve {
+				t.Fatalf("resolved not expected, got:%v", thread.Resolved)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const defaultTimeLayout = "2006-01-02 15:04"
+
+// Discussion represents a comment thread for archiving, with its anchor and the names of its authors.
+type Discussion struct {
+	ThreadID   string               `json:"threadId"`
+	Anchor     *Anchor              `json:"anchor"`
+	Resolved   bool                 `json:"resolved"`
+	ResolvedAt *time.Time           `json:"resolvedAt,omitempty"`
+	ResolvedBy *Author              `json:"resolvedBy,omitempty"`
+	Comments   []*DiscussionComment `json:"comments"`
+}
+
+// Anchor represents where a thread is on the board, a widget or a point of the board itself.
+// Type and Text are only set when the widget is in the snapshot.
+type Anchor struct {
+	WidgetID string          `json:"widgetId,omitempty"`
+	Type     miro.WidgetType `json:"type,omitempty"`
+	Text     string          `json:"text,omitempty"`
+	Position *miro.Point     `json:"position,omitempty"`
+}
+
+// Author represents the user who wrote a comment or resolved a thread.
+type Author struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// DiscussionComment represents a comment of a discussion as plain text.
+// ModifiedAt is only set when the comment was edited after being posted.
+type DiscussionComment struct {
+	ID         string     `json:"id"`
+	Author     *Author    `json:"author"`
+	Text       string     `json:"text"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ModifiedAt *time.Time `json:"modifiedAt,omitempty"`
+}
+
+// DiscussionsOptions specifies the parameters of DiscussionsMarkdown.
+type DiscussionsOptions struct {
+	// TimeLayout is the layout of the times of the comments. Empty uses 2006-01-02 15:04.
+	TimeLayout string
+
+	// Unresolved leaves the resolved threads out.
+	Unresolved bool
+}
+
+// FetchDiscussions fetches the comment threads of the board by Board ID and returns them as discussions.
+// The snapshot names the anchor widgets and the authors, nil leaves them to the IDs Miro returns.
+func FetchDiscussions(ctx context.Context, client *miro.Client, boardID string, s *Snapshot) ([]*Discussion, error) {
+	threads, _, err := client.Comments.List(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Discussions(threads, s), nil
+}
+
+// Discussions returns the threads as discussions, the oldest thread first and the comments of a thread in
+// the order they were posted. The snapshot names the anchor widgets and the authors, nil leaves them to the
+// IDs Miro returns.
+func Discussions(threads []*miro.CommentThread, s *Snapshot) []*Discussion {
+	widgets := map[string]miro.Widget{}
+	if s != nil {
+		for _, w := range s.Widgets {
+			widgets[w.Base().ID] = w
+		}
+	}
+
+	sorted := append([]*miro.CommentThread{}, threads...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	discussions := make([]*Discussion, 0, len(sorted))
+	for _, t := range sorted {
+		d := &Discussion{
+			ThreadID:   t.ID,
+			Anchor:     &Anchor{WidgetID: t.WidgetID, Position: t.Position},
+			Resolved:   t.Resolved,
+			ResolvedAt: t.ResolvedAt,
+			ResolvedBy: author(t.ResolvedBy, s),
+			Comments:   make([]*DiscussionComment, 0, len(t.Comments)),
+		}
+
+		if w, ok := widgets[t.WidgetID]; ok {
+			d.Anchor.Type = w.Base().Type
+			d.Anchor.Text = text(w)
+		}
+
+		comments := append([]*miro.Comment{}, t.Comments...)
+		sort.SliceStable(comments, func(i, j int) bool {
+			return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+		})
+
+		for _, c := range comments {
+			dc := &DiscussionComment{
+				ID:        c.ID,
+				Author:    author(c.CreatedBy, s),
+				Text:      plainText(c.Text),
+				CreatedAt: c.CreatedAt,
+			}
+
+			if c.ModifiedAt.After(c.CreatedAt) {
+				modifiedAt := c.ModifiedAt
+				dc.ModifiedAt = &modifiedAt
+			}
+
+			d.Comments = append(d.Comments, dc)
+		}
+
+		discussions = append(discussions, d)
+	}
+
+	return discussions
+}
+
+// author returns the user as an author, named by the snapshot when Miro returns no name.
+func author(u *miro.MiniUser, s *Snapshot) *Author {
+	if u == nil {
+		return nil
+	}
+
+	name := u.Name
+	if name == "" {
+		name = u.ID
+		if s != nil {
+			name = s.memberName(u.ID)
+		}
+	}
+
+	return &Author{ID: u.ID, Name: name}
+}
+
+// DiscussionsMarkdown writes the discussions as Markdown, a heading naming the anchor per discussion
+// followed by its comments as a list, with their authors and times.
+func DiscussionsMarkdown(w io.Writer, discussions []*Discussion, opt DiscussionsOptions) error {
+	if opt.TimeLayout == "" {
+		opt.TimeLayout = defaultTimeLayout
+	}
+
+	m := &markdown{}
+	for _, d := range discussions {
+		if opt.Unresolved && d.Resolved {
+			continue
+		}
+
+		m.heading(2, escape(d.Anchor.title()))
+
+		if d.Resolved {
+			line := "Resolved"
+			if d.ResolvedBy != nil {
+				line += " by " + inlineEscaper.Replace(d.ResolvedBy.Name)
+			}
+			if d.ResolvedAt != nil {
+				line += " on " + d.ResolvedAt.Format(opt.TimeLayout)
+			}
+			m.block("_" + line + "_")
+		}
+
+		for _, c := range d.Comments {
+			name := "Unknown"
+			if c.Author != nil {
+				name = inlineEscaper.Replace(c.Author.Name)
+			}
+
+			when := c.CreatedAt.Format(opt.TimeLayout)
+			if c.ModifiedAt != nil {
+				when += ", edited " + c.ModifiedAt.Format(opt.TimeLayout)
+			}
+
+			m.item("comment", fmt.Sprintf("- **%s** (%s): %s", name, when, indent(escape(c.Text))))
+		}
+	}
+
+	_, err := io.WriteString(w, strings.TrimRight(m.b.String(), "\n")+"\n")
+	return err
+}
+
+// DiscussionsJSON writes the discussions as indented JSON.
+func DiscussionsJSON(w io.Writer, discussions []*Discussion) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(discussions)
+}
+
+// title returns the heading of the discussions on the anchor.
+func (a *Anchor) title() string {
+	switch {
+	case a.WidgetID == "" && a.Position != nil:
+		return fmt.Sprintf("Board at (%g, %g)", a.Position.X, a.Position.Y)
+	case a.WidgetID == "":
+		return "Board"
+	case a.Text != "":
+		return fmt.Sprintf("%s %s: %s", capitalize(string(a.Type)), a.WidgetID, firstLine(a.Text))
+	case a.Type != "":
+		return fmt.Sprintf("%s %s", capitalize(string(a.Type)), a.WidgetID)
+	}
+
+	return "Widget " + a.WidgetID
+}
+
+// capitalize returns s with its first letter in upper case.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func firstLine(s string) string {
+	if i := strings.Index(s, "\n"); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
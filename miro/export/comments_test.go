@@ -0,0 +1,221 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/Miro-Ecosystem/go-miro/miro/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+)
+
+func at(hour, min int) time.Time {
+	return time.Date(2022, 3, 1, hour, min, 0, 0, time.UTC)
+}
+
+// review returns the comment threads of a design review on the retro board.
+func review() []*miro.CommentThread {
+	alice := &miro.MiniUser{ID: "alice"}
+	bob := &miro.MiniUser{ID: "bob", Name: "Bob"}
+	resolvedAt := at(11, 0)
+
+	return []*miro.CommentThread{
+		{
+			ID:        "t2",
+			Position:  &miro.Point{X: 10, Y: -5},
+			Comments:  []*miro.Comment{{ID: "c3", Text: "<p>Add a legend</p>", CreatedAt: at(10, 30), ModifiedAt: at(10, 30), CreatedBy: bob}},
+			CreatedAt: at(10, 30),
+		},
+		{
+			ID:         "t1",
+			WidgetID:   "s2",
+			Resolved:   true,
+			ResolvedAt: &resolvedAt,
+			ResolvedBy: alice,
+			Comments: []*miro.Comment{
+				{ID: "c2", Text: "<p>Caching</p><p>next sprint</p>", CreatedAt: at(10, 10), ModifiedAt: at(10, 10), CreatedBy: alice},
+				{ID: "c1", Text: "<p>How slow &amp; why?</p>", CreatedAt: at(10, 0), ModifiedAt: at(10, 5), CreatedBy: bob},
+			},
+			CreatedAt: at(10, 0),
+		},
+		{
+			ID:        "t3",
+			WidgetID:  "gone",
+			Comments:  []*miro.Comment{{ID: "c4", Text: "Orphan", CreatedAt: at(12, 0), ModifiedAt: at(12, 0)}},
+			CreatedAt: at(12, 0),
+		},
+	}
+}
+
+func TestDiscussions(t *testing.T) {
+	got := Discussions(review(), retro())
+
+	modifiedAt, resolvedAt := at(10, 5), at(11, 0)
+	want := []*Discussion{
+		{
+			ThreadID:   "t1",
+			Anchor:     &Anchor{WidgetID: "s2", Type: miro.WidgetTypeSticker, Text: "Slow CI"},
+			Resolved:   true,
+			ResolvedAt: &resolvedAt,
+			ResolvedBy: &Author{ID: "alice", Name: "Alice"},
+			Comments: []*DiscussionComment{
+				{ID: "c1", Author: &Author{ID: "bob", Name: "Bob"}, Text: "How slow & why?", CreatedAt: at(10, 0), ModifiedAt: &modifiedAt},
+				{ID: "c2", Author: &Author{ID: "alice", Name: "Alice"}, Text: "Caching\nnext sprint", CreatedAt: at(10, 10)},
+			},
+		},
+		{
+			ThreadID: "t2",
+			Anchor:   &Anchor{Position: &miro.Point{X: 10, Y: -5}},
+			Comments: []*DiscussionComment{{ID: "c3", Author: &Author{ID: "bob", Name: "Bob"}, Text: "Add a legend", CreatedAt: at(10, 30)}},
+		},
+		{
+			ThreadID: "t3",
+			Anchor:   &Anchor{WidgetID: "gone"},
+			Comments: []*DiscussionComment{{ID: "c4", Text: "Orphan", CreatedAt: at(12, 0)}},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if got := Discussions(review(), nil)[0].ResolvedBy; got.Name != "alice" {
+		t.Fatalf("name not expected, got:%s", got.Name)
+	}
+}
+
+func TestDiscussionsMarkdown(t *testing.T) {
+	tcs := map[string]struct {
+		opt  DiscussionsOptions
+		want string
+	}{
+		"all": {DiscussionsOptions{}, `## Sticker s2: Slow CI
+
+_Resolved by Alice on 2022-03-01 11:00_
+
+- **Bob** (2022-03-01 10:00, edited 2022-03-01 10:05): How slow & why?
+- **Alice** (2022-03-01 10:10): Caching
+  next sprint
+
+## Board at (10, -5)
+
+- **Bob** (2022-03-01 10:30): Add a legend
+
+## Widget gone
+
+- **Unknown** (2022-03-01 12:00): Orphan
+`},
+		"unresolved": {DiscussionsOptions{TimeLayout: "15:04", Unresolved: true}, `## Board at (10, -5)
+
+- **Bob** (10:30): Add a legend
+
+## Widget gone
+
+- **Unknown** (12:00): Orphan
+`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := DiscussionsMarkdown(&b, Discussions(review(), retro()), tc.opt); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(b.String(), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestDiscussionsJSON(t *testing.T) {
+	var b bytes.Buffer
+	if err := DiscussionsJSON(&b, Discussions(review()[:1], nil)); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `[
+  {
+    "threadId": "t2",
+    "anchor": {
+      "position": {
+        "x": 10,
+        "y": -5
+      }
+    },
+    "resolved": false,
+    "comments": [
+      {
+        "id": "c3",
+        "author": {
+          "id": "bob",
+          "name": "Bob"
+        },
+        "text": "Add a legend",
+        "createdAt": "2022-03-01T10:30:00Z"
+      }
+    ]
+  }
+]
+`
+	if diff := cmp.Diff(b.String(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestFetchDiscussions(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	mux.HandleFunc("/boards/board/comments", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type": "collection", "data": [{"id": "t1", "widgetId": "s1", "comments": [{"id": "c1", "text": "Nice", "createdBy": {"id": "alice"}}]}]}`)
+	})
+
+	got, err := FetchDiscussions(context.Background(), client, "board", retro())
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []*Discussion{{
+		ThreadID: "t1",
+		Anchor:   &Anchor{WidgetID: "s1", Type: miro.WidgetTypeSticker, Text: "Pairing\nworks"},
+		Comments: []*DiscussionComment{{ID: "c1", Author: &Author{ID: "alice", Name: "Alice"}, Text: "Nice"}},
+	}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestFetchDiscussions_Mock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	comments := mock.NewMockCommentsAPI(ctrl)
+
+	client := miro.NewClient("miro-test")
+	client.Comments = comments
+
+	ctx := context.Background()
+	comments.EXPECT().List(ctx, "board").Return(review()[:1], nil, nil)
+
+	got, err := FetchDiscussions(ctx, client, "board", nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(len(got), 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	comments.EXPECT().List(ctx, "board").Return(nil, nil, errors.New("unavailable"))
+
+	if _, err := FetchDiscussions(ctx, client, "board", nil); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
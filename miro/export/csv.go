@@ -0,0 +1,105 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// csvHeader is the header of the CSV export.
+var csvHeader = []string{
+	"id", "type", "frame", "text", "description", "color", "tags", "assignee", "dueDate",
+	"x", "y", "width", "height", "createdAt", "modifiedAt",
+}
+
+// CSV writes a row per widget in the order of the snapshot, with the header first.
+// The frame column is the ID of the frame containing the widget, and the text column
+// is the plain text of the widget, the title of cards and frames.
+func CSV(w io.Writer, s *Snapshot) error {
+	frames := map[string]string{}
+	var walk func(n *miro.FrameNode)
+	walk = func(n *miro.FrameNode) {
+		for _, f := range n.Frames {
+			if n.Frame != nil {
+				frames[f.Frame.ID] = n.Frame.ID
+			}
+			walk(f)
+		}
+
+		for _, c := range n.Widgets {
+			if n.Frame != nil {
+				frames[c.Base().ID] = n.Frame.ID
+			}
+		}
+	}
+	walk(miro.BuildFrameTree(s.Widgets))
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, wd := range s.Widgets {
+		b := wd.Base()
+
+		var description, assignee, due string
+		var tags []*miro.Tag
+		switch wd := wd.(type) {
+		case *miro.Sticker:
+			tags = wd.Tags
+		case *miro.Card:
+			description = plainText(wd.Description)
+			tags = wd.Tags
+			if wd.Assignee != nil {
+				assignee = wd.Assignee.UserID
+			}
+			if wd.DueDate != nil {
+				due = wd.DueDate.Format(time.RFC3339)
+			}
+		}
+
+		titles := make([]string, 0, len(tags))
+		for _, t := range tags {
+			titles = append(titles, t.Title)
+		}
+
+		if err := cw.Write([]string{
+			b.ID,
+			string(b.Type),
+			frames[b.ID],
+			text(wd),
+			description,
+			color(wd),
+			strings.Join(titles, ","),
+			assignee,
+			due,
+			formatFloat(b.X),
+			formatFloat(b.Y),
+			formatFloat(b.Width),
+			formatFloat(b.Height),
+			formatTime(b.CreatedAt),
+			formatTime(b.ModifiedAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
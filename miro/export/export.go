@@ -0,0 +1,101 @@
+// Package export exports the content of a Miro board to Markdown, CSV and JSON,
+// and its comment threads to Markdown and JSON for archiving.
+//
+// Fetch takes a Snapshot of the board, its members and its widgets, which the
+// exporters write without calling Miro, so that one snapshot can be exported to
+// every format.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Snapshot represents the content of a board at a point in time.
+type Snapshot struct {
+	Board      *miro.Board                 `json:"board"`
+	Members    []*miro.BoardUserConnection `json:"members"`
+	Widgets    []miro.Widget               `json:"widgets"`
+	ExportedAt time.Time                   `json:"exportedAt"`
+}
+
+// Fetch takes a snapshot of the board by Board ID.
+func Fetch(ctx context.Context, client *miro.Client, boardID string) (*Snapshot, error) {
+	board, _, err := client.Boards.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, _, err := client.BoardUserConnection.ListAllMembers(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	widgets, _, err := client.Widgets.List(ctx, boardID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		Board:      board,
+		Members:    members,
+		Widgets:    widgets,
+		ExportedAt: time.Now(),
+	}, nil
+}
+
+// memberName returns the name of the board member by User ID, the User ID itself if not a member.
+func (s *Snapshot) memberName(userID string) string {
+	for _, m := range s.Members {
+		if m.User != nil && m.User.ID == userID && m.User.Name != "" {
+			return m.User.Name
+		}
+	}
+
+	return userID
+}
+
+// UnmarshalJSON decodes the snapshot, decoding the widgets into their widget types.
+func (s *Snapshot) UnmarshalJSON(data []byte) error {
+	type alias Snapshot
+	v := struct {
+		*alias
+		Widgets []json.RawMessage `json:"widgets"`
+	}{alias: (*alias)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	s.Widgets = make([]miro.Widget, 0, len(v.Widgets))
+	for _, raw := range v.Widgets {
+		w, err := miro.DecodeWidget(raw)
+		if err != nil {
+			return err
+		}
+		s.Widgets = append(s.Widgets, w)
+	}
+
+	return nil
+}
+
+// JSON writes the full snapshot as indented JSON, which ReadJSON reads back.
+func JSON(w io.Writer, s *Snapshot) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(s)
+}
+
+// ReadJSON reads a snapshot written by JSON.
+func ReadJSON(r io.Reader) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
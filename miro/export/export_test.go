@@ -0,0 +1,143 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// retro returns the snapshot of a retrospective board.
+func retro() *Snapshot {
+	due := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	green := &miro.WidgetStyle{BackgroundColor: "#d5f692"}
+	red := &miro.WidgetStyle{BackgroundColor: "#f24726"}
+
+	return &Snapshot{
+		Board: &miro.Board{ID: "board", Name: "Retro", Description: "Sprint 12"},
+		Members: []*miro.BoardUserConnection{
+			{ID: "c1", User: &miro.MiniUser{ID: "alice", Name: "Alice"}, Role: miro.BoardRoleOwner},
+		},
+		Widgets: []miro.Widget{
+			&miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s2", Type: miro.WidgetTypeSticker, X: 300, Y: 100}, Text: "<p>Slow CI</p>", Style: red},
+			&miro.Frame{
+				WidgetBase: miro.WidgetBase{ID: "went-well", Type: miro.WidgetTypeFrame, X: 100, Y: 100, Width: 200, Height: 200},
+				Title:      "Went well",
+				Children:   []string{"s1", "s2", "s3", "actions"},
+			},
+			&miro.Text{WidgetBase: miro.WidgetBase{ID: "t1", Type: miro.WidgetTypeText, X: 0, Y: -100}, Text: "<p>Team &amp; process</p>"},
+			&miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s1", Type: miro.WidgetTypeSticker, X: 100, Y: 100}, Text: "Pairing<br>works", Style: green},
+			&miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s3", Type: miro.WidgetTypeSticker, X: 200, Y: 100}, Text: "Demos", Style: green},
+			&miro.Frame{
+				WidgetBase: miro.WidgetBase{ID: "actions", Type: miro.WidgetTypeFrame, X: 100, Y: 400, Width: 200, Height: 100},
+				Children:   []string{"c1", "c2"},
+			},
+			&miro.Card{
+				WidgetBase:  miro.WidgetBase{ID: "c1", Type: miro.WidgetTypeCard, X: 100, Y: 400},
+				Title:       "Cache modules",
+				Description: "<p>In CI</p>",
+				Assignee:    &miro.CardAssignee{UserID: "alice"},
+				DueDate:     &due,
+				Tags:        []*miro.Tag{{Title: "ci"}, {Title: "infra"}},
+			},
+			&miro.Card{WidgetBase: miro.WidgetBase{ID: "c2", Type: miro.WidgetTypeCard, X: 100, Y: 450}, Title: "Retro again", Assignee: &miro.CardAssignee{UserID: "bob"}},
+			&miro.Line{WidgetBase: miro.WidgetBase{ID: "l1", Type: miro.WidgetTypeLine}},
+		},
+		ExportedAt: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFetch(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	mux.HandleFunc("/boards/board", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "board", "name": "Retro"}`)
+	})
+
+	mux.HandleFunc("/boards/board/user-connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"size": 1, "data": [{"id": "c1", "role": "owner", "user": {"id": "alice", "name": "Alice"}}]}`)
+	})
+
+	mux.HandleFunc("/boards/board/widgets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type": "collection", "data": [{"id": "s1", "type": "sticker", "text": "hello"}]}`)
+	})
+
+	got, err := Fetch(context.Background(), client, "board")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got.Board.Name, "Retro"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(got.memberName("alice"), "Alice"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	want := []miro.Widget{&miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s1", Type: miro.WidgetTypeSticker}, Text: "hello"}}
+	if diff := cmp.Diff(got.Widgets, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	want := retro()
+
+	buf := &bytes.Buffer{}
+	if err := JSON(buf, want); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := ReadJSON(buf)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	s := retro()
+	s.Widgets = s.Widgets[:2]
+	s.Widgets = append(s.Widgets, retro().Widgets[6])
+
+	buf := &bytes.Buffer{}
+	if err := CSV(buf, s); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := `id,type,frame,text,description,color,tags,assignee,dueDate,x,y,width,height,createdAt,modifiedAt
+s2,sticker,went-well,Slow CI,,#f24726,,,,300,100,0,0,,
+went-well,frame,,Went well,,,,,,100,100,200,200,,
+c1,card,,Cache modules,In CI,,"ci,infra",alice,2022-03-01T00:00:00Z,100,400,0,0,,
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	tcs := map[string]string{
+		"plain":            "plain",
+		"<p>a</p>":         "a",
+		"<p>a</p><p>b</p>": "a\nb",
+		"a<br/>b<br>c":     "a\nb\nc",
+		"<p><strong>Tom</strong> &amp; Jerry&nbsp;</p><p></p>": "Tom & Jerry",
+	}
+
+	for in, want := range tcs {
+		if diff := cmp.Diff(plainText(in), want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	}
+}
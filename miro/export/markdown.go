@@ -0,0 +1,229 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const (
+	defaultDateLayout = "2006-01-02"
+	untitledFrame     = "Untitled frame"
+)
+
+// MarkdownOptions specifies the parameters of Markdown.
+type MarkdownOptions struct {
+	// StickersByColor groups the stickers of every frame by background color
+	// after the other widgets of the frame, instead of in reading order.
+	StickersByColor bool
+
+	// ColorNames names the colors of the sticker groups, such as "#f24726" to "Problems".
+	// Colors missing from it are named by their value.
+	ColorNames map[string]string
+
+	// DateLayout is the layout of the due dates of cards. Empty uses 2006-01-02.
+	DateLayout string
+}
+
+// Markdown writes the board as Markdown.
+// Frames are headings nested like the frames, the widgets of a frame follow its heading in reading order:
+// texts and shapes as paragraphs, stickers as lists and cards as task lists with their assignees and due dates.
+// Lines and widgets of unknown types are left out.
+func Markdown(w io.Writer, s *Snapshot, opt MarkdownOptions) error {
+	if opt.DateLayout == "" {
+		opt.DateLayout = defaultDateLayout
+	}
+
+	m := &markdown{snapshot: s, opt: opt}
+	if s.Board != nil {
+		m.heading(1, escape(s.Board.Name))
+		if d := strings.TrimSpace(s.Board.Description); d != "" {
+			m.block(escape(d))
+		}
+	}
+
+	m.node(miro.BuildFrameTree(s.Widgets), 1)
+
+	_, err := io.WriteString(w, strings.TrimRight(m.b.String(), "\n")+"\n")
+	return err
+}
+
+type markdown struct {
+	snapshot *Snapshot
+	opt      MarkdownOptions
+	b        strings.Builder
+
+	// list is the kind of the list being written, to separate the lists from the other blocks.
+	list string
+}
+
+func (m *markdown) node(n *miro.FrameNode, depth int) {
+	stickers := map[string][]*miro.Sticker{}
+	colors := []string{}
+
+	for _, w := range readingOrder(n.Widgets) {
+		switch w := w.(type) {
+		case *miro.Sticker:
+			if !m.opt.StickersByColor {
+				m.sticker(w)
+				continue
+			}
+
+			c := color(w)
+			if _, ok := stickers[c]; !ok {
+				colors = append(colors, c)
+			}
+			stickers[c] = append(stickers[c], w)
+		case *miro.Card:
+			m.card(w)
+		case *miro.Text, *miro.Shape:
+			if t := text(w); t != "" {
+				m.block(escape(t))
+			}
+		}
+	}
+
+	for _, c := range colors {
+		name := c
+		if named, ok := m.opt.ColorNames[c]; ok {
+			name = named
+		} else if name == "" {
+			name = "No color"
+		}
+
+		m.block(fmt.Sprintf("**%s**", inlineEscaper.Replace(name)))
+		for _, st := range stickers[c] {
+			m.sticker(st)
+		}
+	}
+
+	for _, f := range readingOrderFrames(n.Frames) {
+		title := text(f.Frame)
+		if title == "" {
+			title = untitledFrame
+		}
+
+		m.heading(depth+1, escape(title))
+		m.node(f, depth+1)
+	}
+}
+
+func (m *markdown) sticker(s *miro.Sticker) {
+	if t := text(s); t != "" {
+		m.item("sticker", "- "+indent(escape(t)))
+	}
+}
+
+func (m *markdown) card(c *miro.Card) {
+	line := "- [ ] " + escape(text(c))
+
+	details := []string{}
+	if c.Assignee != nil && c.Assignee.UserID != "" {
+		details = append(details, "@"+inlineEscaper.Replace(m.snapshot.memberName(c.Assignee.UserID)))
+	}
+
+	if c.DueDate != nil {
+		details = append(details, "due "+c.DueDate.Format(m.opt.DateLayout))
+	}
+
+	if len(details) > 0 {
+		line += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	if d := plainText(c.Description); d != "" {
+		line += "\n  " + indent(escape(d))
+	}
+
+	m.item("card", line)
+}
+
+func (m *markdown) heading(level int, title string) {
+	if level > 6 {
+		level = 6
+	}
+
+	m.block(strings.Repeat("#", level) + " " + title)
+}
+
+// block writes a block separated from the previous one by a blank line.
+func (m *markdown) block(s string) {
+	m.endList()
+	m.b.WriteString(s + "\n\n")
+}
+
+// item writes an item of a list of the kind, starting a new list after other blocks.
+func (m *markdown) item(kind, s string) {
+	if m.list != kind {
+		m.endList()
+		m.list = kind
+	}
+
+	m.b.WriteString(s + "\n")
+}
+
+func (m *markdown) endList() {
+	if m.list != "" {
+		m.b.WriteString("\n")
+		m.list = ""
+	}
+}
+
+// escape escapes the Markdown metacharacters of the text of board content, so that it is written as is:
+// the inline metacharacters anywhere and the characters starting headings, quotes and lists at line starts.
+func escape(s string) string {
+	lines := strings.Split(inlineEscaper.Replace(s), "\n")
+	for i, l := range lines {
+		lines[i] = escapeLineStart(l)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// inlineEscaper escapes the inline metacharacters, for the names written within a line.
+var inlineEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `|`, `\|`, `<`, `\<`,
+)
+
+// escapeLineStart escapes the character starting a heading, a quote, a list or a setext underline of the line.
+func escapeLineStart(l string) string {
+	trimmed := strings.TrimLeft(l, " ")
+	lead := l[:len(l)-len(trimmed)]
+	if trimmed == "" {
+		return l
+	}
+
+	switch trimmed[0] {
+	case '#', '>', '-', '+', '=':
+		return lead + `\` + trimmed
+	}
+
+	digits := len(trimmed) - len(strings.TrimLeft(trimmed, "0123456789"))
+	if digits > 0 && digits < len(trimmed) && (trimmed[digits] == '.' || trimmed[digits] == ')') {
+		return lead + trimmed[:digits] + `\` + trimmed[digits:]
+	}
+
+	return l
+}
+
+// indent indents the lines after the first one of s to continue a list item.
+func indent(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n  ")
+}
+
+func readingOrderFrames(nodes []*miro.FrameNode) []*miro.FrameNode {
+	widgets := make([]miro.Widget, len(nodes))
+	byFrame := make(map[miro.Widget]*miro.FrameNode, len(nodes))
+	for i, n := range nodes {
+		widgets[i] = n.Frame
+		byFrame[n.Frame] = n
+	}
+
+	sorted := make([]*miro.FrameNode, 0, len(nodes))
+	for _, w := range readingOrder(widgets) {
+		sorted = append(sorted, byFrame[w])
+	}
+
+	return sorted
+}
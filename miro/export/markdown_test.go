@@ -0,0 +1,97 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarkdown(t *testing.T) {
+	tcs := map[string]struct {
+		opt  MarkdownOptions
+		want string
+	}{
+		"reading order": {MarkdownOptions{}, `# Retro
+
+Sprint 12
+
+Team & process
+
+## Went well
+
+- Pairing
+  works
+- Demos
+- Slow CI
+
+### Untitled frame
+
+- [ ] Cache modules (@Alice, due 2022-03-01)
+  In CI
+- [ ] Retro again (@bob)
+`},
+		"stickers by color": {MarkdownOptions{StickersByColor: true, ColorNames: map[string]string{"#d5f692": "Keep"}, DateLayout: "Jan 2"}, `# Retro
+
+Sprint 12
+
+Team & process
+
+## Went well
+
+**Keep**
+
+- Pairing
+  works
+- Demos
+
+**#f24726**
+
+- Slow CI
+
+### Untitled frame
+
+- [ ] Cache modules (@Alice, due Mar 1)
+  In CI
+- [ ] Retro again (@bob)
+`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := Markdown(buf, retro(), tc.opt); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(buf.String(), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tcs := map[string]struct {
+		in   string
+		want string
+	}{
+		"plain":     {"Team & process", "Team & process"},
+		"inline":    {"a|b *c* _d_ `e` [f](g) <h> \\", "a\\|b \\*c\\* \\_d\\_ \\`e\\` \\[f\\](g) \\<h> \\\\"},
+		"heading":   {"# Title", "\\# Title"},
+		"list":      {"- item\n  + nested", "\\- item\n  \\+ nested"},
+		"ordered":   {"1. first\n2) second", "1\\. first\n2\\) second"},
+		"quote":     {"> said", "\\> said"},
+		"mid line":  {"a # b - c > d", "a # b - c > d"},
+		"number":    {"2022 plans", "2022 plans"},
+		"separator": {"===", "\\==="},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(escape(tc.in), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
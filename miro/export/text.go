@@ -0,0 +1,89 @@
+package export
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+var (
+	// lineBreaks matches the tags ending a line in the rich text of widgets.
+	lineBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>|</div>`)
+	htmlTags   = regexp.MustCompile(`<[^>]*>`)
+	blankLines = regexp.MustCompile(`\n{2,}`)
+)
+
+// plainText returns the rich text of a widget, which Miro formats as HTML, as plain text.
+func plainText(s string) string {
+	s = lineBreaks.ReplaceAllString(s, "\n")
+	s = htmlTags.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSpace(l)
+	}
+
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n"))
+}
+
+// text returns the plain text of the widget, the title of cards and frames.
+func text(w miro.Widget) string {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return plainText(w.Text)
+	case *miro.Shape:
+		return plainText(w.Text)
+	case *miro.Text:
+		return plainText(w.Text)
+	case *miro.Card:
+		return plainText(w.Title)
+	case *miro.Frame:
+		return plainText(w.Title)
+	}
+
+	return ""
+}
+
+// color returns the background color of the widget.
+func color(w miro.Widget) string {
+	var style *miro.WidgetStyle
+	switch w := w.(type) {
+	case *miro.Sticker:
+		style = w.Style
+	case *miro.Shape:
+		style = w.Style
+	case *miro.Text:
+		style = w.Style
+	case *miro.Card:
+		style = w.Style
+	case *miro.Frame:
+		style = w.Style
+	}
+
+	if style == nil {
+		return ""
+	}
+
+	return style.BackgroundColor
+}
+
+// readingOrder sorts the widgets by their top left corner, top to bottom then left to right.
+func readingOrder(widgets []miro.Widget) []miro.Widget {
+	sorted := append([]miro.Widget{}, widgets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].Base(), sorted[j].Base()
+		at, bt := a.Y-a.Height/2, b.Y-b.Height/2
+		if at != bt {
+			return at < bt
+		}
+
+		return a.X-a.Width/2 < b.X-b.Width/2
+	})
+
+	return sorted
+}
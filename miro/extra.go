@@ -0,0 +1,102 @@
+package miro
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFields caches the lower-cased JSON member names of struct types.
+var knownFields sync.Map
+
+// unmarshalExtra decodes data into v, a pointer to a struct, and returns the members of data
+// that do not match any field of v so that models keep the members added to the API later.
+func unmarshalExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// Not an object, such as null, so nothing is left over.
+		return nil, nil
+	}
+
+	fields := jsonFields(reflect.TypeOf(v).Elem())
+	for k := range raw {
+		if fields[strings.ToLower(k)] {
+			delete(raw, k)
+		}
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	return raw, nil
+}
+
+// marshalExtra encodes v and adds the members of extra that v does not already have.
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, err
+	}
+
+	for k, m := range extra {
+		if _, ok := members[k]; !ok {
+			members[k] = m
+		}
+	}
+
+	return json.Marshal(members)
+}
+
+// jsonFields returns the lower-cased JSON member names of the struct type as encoding/json matches them case-insensitively.
+func jsonFields(t reflect.Type) map[string]bool {
+	if f, ok := knownFields.Load(t); ok {
+		return f.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				for k := range jsonFields(ft) {
+					fields[k] = true
+				}
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = true
+	}
+
+	knownFields.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,91 @@
+package miro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtra_Unmarshal(t *testing.T) {
+	tcs := map[string]struct {
+		in   string
+		want *Board
+	}{
+		"unknown members": {`{
+	"id": "1",
+	"NAME": "board",
+	"type": "board",
+	"labels": ["a", "b"],
+	"owner": {"id": "user", "name": "Sergey", "type": "user"}
+}`, &Board{
+			ID:    "1",
+			Name:  "board",
+			Owner: &MiniUser{ID: "user", Name: "Sergey", Extra: extra("type", `"user"`)},
+			Extra: extra("type", `"board"`, "labels", `["a", "b"]`),
+		}},
+		"no unknown members": {`{"id": "1"}`, &Board{ID: "1"}},
+		"null":               {`null`, &Board{}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := &Board{}
+			if err := json.Unmarshal([]byte(tc.in), got); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestExtra_RoundTrip(t *testing.T) {
+	in := `{
+	"id": "1",
+	"type": "team",
+	"name": "team",
+	"createdAt": "1995-06-15T10:00:00Z",
+	"modifiedAt": "1995-06-15T10:00:00Z",
+	"createdBy": {"id": "user", "name": "Sergey", "type": "user", "email": "miro@test.com"},
+	"modifiedBy": null,
+	"picture": null,
+	"settings": {"sharing": {"default": "private"}}
+}`
+
+	team := &Team{}
+	if err := json.Unmarshal([]byte(in), team); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := json.Marshal(team)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var gotMembers, wantMembers map[string]interface{}
+	json.Unmarshal(got, &gotMembers)
+	json.Unmarshal([]byte(in), &wantMembers)
+
+	if diff := cmp.Diff(gotMembers, wantMembers); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestExtra_KnownFieldsWin(t *testing.T) {
+	u := &MiniUser{
+		ID:    "user",
+		Extra: extra("id", `"other"`, "type", `"user"`),
+	}
+
+	got, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(string(got), `{"id":"user","name":"","type":"user"}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -0,0 +1,287 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Frame object represents Miro Frame, a widget grouping other widgets.
+// Children is the list of the Widget IDs in the frame.
+//
+// API doc: https://developers.miro.com/reference#frame
+//
+//go:generate gomodifytags -file $GOFILE -struct Frame -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Frame -add-tags json -w -transform camelcase
+type Frame struct {
+	WidgetBase
+	Title    string       `json:"title"`
+	Children []string     `json:"children"`
+	Style    *WidgetStyle `json:"style,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// FrameNode represents a frame and the widgets it contains in the frame tree of a board.
+// The root node has no Frame and holds the frames and widgets outside of any frame.
+type FrameNode struct {
+	Frame   *Frame
+	Frames  []*FrameNode
+	Widgets []Widget
+}
+
+// frameChildrenRequest represents update frame children request payload.
+type frameChildrenRequest struct {
+	Children []string `json:"children"`
+}
+
+// TopLeft returns the position of the top left corner of the frame on the board.
+func (f *Frame) TopLeft() Point {
+	return Point{X: f.X - f.Width/2, Y: f.Y - f.Height/2}
+}
+
+// ToBoard converts the position relative to the top left corner of the frame to the position on the board.
+func (f *Frame) ToBoard(p Point) Point {
+	tl := f.TopLeft()
+	return Point{X: tl.X + p.X, Y: tl.Y + p.Y}
+}
+
+// ToFrame converts the position on the board to the position relative to the top left corner of the frame.
+func (f *Frame) ToFrame(p Point) Point {
+	tl := f.TopLeft()
+	return Point{X: p.X - tl.X, Y: p.Y - tl.Y}
+}
+
+// Contains reports whether the position on the board is inside the frame.
+func (f *Frame) Contains(p Point) bool {
+	tl := f.TopLeft()
+	return p.X >= tl.X && p.X <= tl.X+f.Width && p.Y >= tl.Y && p.Y <= tl.Y+f.Height
+}
+
+// HasChild reports whether the widget is in the frame.
+func (f *Frame) HasChild(widgetID string) bool {
+	for _, id := range f.Children {
+		if id == widgetID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetFrame gets the frame on the board by Board ID and Frame ID.
+func (s *WidgetsService) GetFrame(ctx context.Context, boardID, frameID string) (*Frame, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.GetFrame")
+
+	w, resp, err := s.Get(ctx, boardID, frameID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	f, ok := w.(*Frame)
+	if !ok {
+		return nil, resp, fmt.Errorf("widget %s is not a frame, got:%s", frameID, w.Base().Type)
+	}
+
+	return f, resp, nil
+}
+
+// ListFrameChildren lists the widgets in the frame in the order of its children.
+func (s *WidgetsService) ListFrameChildren(ctx context.Context, boardID, frameID string) ([]Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.ListFrameChildren")
+
+	f, resp, err := s.GetFrame(ctx, boardID, frameID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	widgets, resp, err := s.List(ctx, boardID, "")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	byID := make(map[string]Widget, len(widgets))
+	for _, w := range widgets {
+		byID[w.Base().ID] = w
+	}
+
+	children := []Widget{}
+	for _, id := range f.Children {
+		if w, ok := byID[id]; ok {
+			children = append(children, w)
+		}
+	}
+
+	return children, resp, nil
+}
+
+// MoveIntoFrame adds the widgets to the frame. Their positions on the board are kept.
+func (s *WidgetsService) MoveIntoFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*Frame, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.MoveIntoFrame")
+
+	f, resp, err := s.GetFrame(ctx, boardID, frameID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	children := append([]string{}, f.Children...)
+	for _, id := range widgetIDs {
+		if !f.HasChild(id) && id != frameID {
+			children = append(children, id)
+		}
+	}
+
+	return s.updateFrameChildren(ctx, boardID, frameID, children)
+}
+
+// MoveOutOfFrame removes the widgets from the frame. Their positions on the board are kept.
+func (s *WidgetsService) MoveOutOfFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*Frame, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.MoveOutOfFrame")
+
+	f, resp, err := s.GetFrame(ctx, boardID, frameID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	remove := make(map[string]bool, len(widgetIDs))
+	for _, id := range widgetIDs {
+		remove[id] = true
+	}
+
+	children := []string{}
+	for _, id := range f.Children {
+		if !remove[id] {
+			children = append(children, id)
+		}
+	}
+
+	return s.updateFrameChildren(ctx, boardID, frameID, children)
+}
+
+// PlaceInFrame moves the widget to the position relative to the top left corner of the frame and adds it to the frame.
+// The frame is returned as it is after the move, fetched again when the widget was already in the frame.
+func (s *WidgetsService) PlaceInFrame(ctx context.Context, boardID, frameID, widgetID string, p Point) (*Frame, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.PlaceInFrame")
+
+	f, resp, err := s.GetFrame(ctx, boardID, frameID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if _, resp, err := s.Move(ctx, boardID, widgetID, f.ToBoard(p)); err != nil {
+		return nil, resp, err
+	}
+
+	if f.HasChild(widgetID) {
+		return s.GetFrame(ctx, boardID, frameID)
+	}
+
+	return s.updateFrameChildren(ctx, boardID, frameID, append(append([]string{}, f.Children...), widgetID))
+}
+
+// FrameTree lists every widget on the board and returns them organized by the frames containing them.
+func (s *WidgetsService) FrameTree(ctx context.Context, boardID string) (*FrameNode, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.FrameTree")
+
+	widgets, resp, err := s.List(ctx, boardID, "")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return BuildFrameTree(widgets), resp, nil
+}
+
+func (s *WidgetsService) updateFrameChildren(ctx context.Context, boardID, frameID string, children []string) (*Frame, *Response, error) {
+	w, resp, err := s.patch(ctx, boardID, frameID, &frameChildrenRequest{Children: children})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	f, ok := w.(*Frame)
+	if !ok {
+		return nil, resp, fmt.Errorf("widget %s is not a frame, got:%s", frameID, w.Base().Type)
+	}
+
+	return f, resp, nil
+}
+
+// BuildFrameTree organizes the widgets by the frames containing them, keeping the order of widgets.
+// A widget listed in the children of several frames belongs to the first of them,
+// and frames containing each other are moved to the root to break the cycle.
+func BuildFrameTree(widgets []Widget) *FrameNode {
+	frames := []*Frame{}
+	for _, w := range widgets {
+		if f, ok := w.(*Frame); ok {
+			frames = append(frames, f)
+		}
+	}
+
+	parent := map[string]string{}
+	for _, f := range frames {
+		for _, id := range f.Children {
+			if _, ok := parent[id]; !ok && id != f.ID {
+				parent[id] = f.ID
+			}
+		}
+	}
+
+	for _, f := range frames {
+		seen := map[string]bool{}
+		for id, ok := parent[f.ID]; ok && !seen[id]; id, ok = parent[id] {
+			if id == f.ID {
+				delete(parent, f.ID)
+				break
+			}
+			seen[id] = true
+		}
+	}
+
+	root := &FrameNode{Frames: []*FrameNode{}, Widgets: []Widget{}}
+	nodes := make(map[string]*FrameNode, len(frames))
+	for _, f := range frames {
+		nodes[f.ID] = &FrameNode{Frame: f, Frames: []*FrameNode{}, Widgets: []Widget{}}
+	}
+
+	nodeOf := func(id string) *FrameNode {
+		if n, ok := nodes[parent[id]]; ok {
+			return n
+		}
+
+		return root
+	}
+
+	for _, w := range widgets {
+		id := w.Base().ID
+		if f, ok := w.(*Frame); ok {
+			n := nodeOf(id)
+			n.Frames = append(n.Frames, nodes[f.ID])
+			continue
+		}
+
+		n := nodeOf(id)
+		n.Widgets = append(n.Widgets, w)
+	}
+
+	return root
+}
+
+// UnmarshalJSON decodes the frame keeping the members unknown to this package in Extra.
+func (f *Frame) UnmarshalJSON(data []byte) error {
+	type alias Frame
+	extra, err := unmarshalExtra(data, (*alias)(f))
+	if err != nil {
+		return err
+	}
+
+	f.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the frame with the members in Extra.
+func (f Frame) MarshalJSON() ([]byte, error) {
+	type alias Frame
+	if f.Type == "" {
+		f.Type = WidgetTypeFrame
+	}
+	return marshalExtra(alias(f), f.Extra)
+}
@@ -0,0 +1,263 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getFrameJSON(id string, x, y, width, height float64, children ...string) string {
+	c, _ := json.Marshal(children)
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "frame",
+	"x": %g,
+	"y": %g,
+	"width": %g,
+	"height": %g,
+	"title": "frame %s",
+	"children": %s
+}`, id, x, y, width, height, id, c)
+}
+
+func getFrame(id string, x, y, width, height float64, children ...string) *Frame {
+	if children == nil {
+		children = []string{}
+	}
+
+	return &Frame{
+		WidgetBase: WidgetBase{ID: id, Type: WidgetTypeFrame, X: x, Y: y, Width: width, Height: height},
+		Title:      "frame " + id,
+		Children:   children,
+	}
+}
+
+func TestFrame_Coordinates(t *testing.T) {
+	f := getFrame("1", 100, 50, 200, 100)
+
+	tcs := map[string]struct {
+		frame    Point
+		board    Point
+		contains bool
+	}{
+		"top left":     {Point{X: 0, Y: 0}, Point{X: 0, Y: 0}, true},
+		"center":       {Point{X: 100, Y: 50}, Point{X: 100, Y: 50}, true},
+		"bottom right": {Point{X: 200, Y: 100}, Point{X: 200, Y: 100}, true},
+		"outside":      {Point{X: -10, Y: 20}, Point{X: -10, Y: 20}, false},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(f.ToBoard(tc.frame), tc.board); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(f.ToFrame(tc.board), tc.frame); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if got := f.Contains(tc.board); got != tc.contains {
+				t.Fatalf("contains not expected, got:%v", got)
+			}
+		})
+	}
+
+	moved := getFrame("2", 0, 0, 200, 100)
+	if diff := cmp.Diff(moved.ToBoard(Point{X: 10, Y: 10}), Point{X: -90, Y: -40}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestBuildFrameTree(t *testing.T) {
+	sticker := func(id string) *Sticker {
+		return &Sticker{WidgetBase: WidgetBase{ID: id, Type: WidgetTypeSticker}}
+	}
+
+	outer := getFrame("outer", 0, 0, 100, 100, "inner", "1")
+	inner := getFrame("inner", 0, 0, 50, 50, "2", "missing")
+	a := getFrame("a", 0, 0, 10, 10, "b")
+	b := getFrame("b", 0, 0, 10, 10, "a", "3")
+
+	tcs := map[string]struct {
+		in   []Widget
+		want *FrameNode
+	}{
+		"nested": {
+			[]Widget{sticker("1"), inner, sticker("2"), outer, sticker("loose")},
+			&FrameNode{
+				Frames: []*FrameNode{{
+					Frame: outer,
+					Frames: []*FrameNode{{
+						Frame:   inner,
+						Frames:  []*FrameNode{},
+						Widgets: []Widget{sticker("2")},
+					}},
+					Widgets: []Widget{sticker("1")},
+				}},
+				Widgets: []Widget{sticker("loose")},
+			},
+		},
+		"cycle": {
+			[]Widget{a, b, sticker("3")},
+			&FrameNode{
+				Frames: []*FrameNode{{
+					Frame: a,
+					Frames: []*FrameNode{{
+						Frame:   b,
+						Frames:  []*FrameNode{},
+						Widgets: []Widget{sticker("3")},
+					}},
+					Widgets: []Widget{},
+				}},
+				Widgets: []Widget{},
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := BuildFrameTree(tc.in)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_MoveIntoFrame(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		frameID   string
+		children  []string
+		move      []string
+		into      bool
+		wantPatch string
+	}{
+		"into":     {"1", []string{"a"}, []string{"a", "b", "1"}, true, `{"children":["a","b"]}`},
+		"out of":   {"2", []string{"a", "b", "c"}, []string{"b"}, false, `{"children":["a","c"]}`},
+		"out none": {"3", []string{"a"}, []string{"a"}, false, `{"children":[]}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			patched := ""
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, widgetsPath, tc.frameID), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPatch {
+					var got json.RawMessage
+					if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+						t.Fatalf("Failed: %v", err)
+					}
+					patched = string(got)
+				}
+
+				fmt.Fprint(w, getFrameJSON(tc.frameID, 0, 0, 100, 100, tc.children...))
+			})
+
+			var err error
+			if tc.into {
+				_, _, err = client.Widgets.MoveIntoFrame(context.Background(), "board", tc.frameID, tc.move...)
+			} else {
+				_, _, err = client.Widgets.MoveOutOfFrame(context.Background(), "board", tc.frameID, tc.move...)
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(patched, tc.wantPatch); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_PlaceInFrame(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	patches := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if r.Method == http.MethodPatch {
+			var got json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+			patches = append(patches, id+" "+string(got))
+		}
+
+		if id == "frame" {
+			fmt.Fprint(w, getFrameJSON("frame", 100, 100, 200, 100))
+			return
+		}
+		fmt.Fprint(w, getStickerJSON(id))
+	})
+
+	if _, _, err := client.Widgets.PlaceInFrame(context.Background(), "board", "frame", "1", Point{X: 20, Y: 30}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []string{`1 {"x":20,"y":80}`, `frame {"children":["1"]}`}
+	if diff := cmp.Diff(patches, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWidgetsService_PlaceInFrame_AlreadyChild(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	gets := 0
+	patches := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if r.Method == http.MethodPatch {
+			patches = append(patches, id)
+		}
+
+		if id == "frame" {
+			gets++
+			// The frame is renamed between the fetches, so that the returned frame tells which fetch it is from.
+			fmt.Fprint(w, strings.Replace(getFrameJSON("frame", 100, 100, 200, 100, "1"), "frame frame", fmt.Sprintf("frame %d", gets), 1))
+			return
+		}
+		fmt.Fprint(w, getStickerJSON(id))
+	})
+
+	got, _, err := client.Widgets.PlaceInFrame(context.Background(), "board", "frame", "1", Point{X: 20, Y: 30})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(patches, []string{"1"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if got.Title != "frame 2" {
+		t.Fatalf("Frame not fetched again, got:%s", got.Title)
+	}
+}
+
+func TestWidgetsService_GetFrame_NotFrame(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getStickerJSON("1"))
+	})
+
+	_, _, err := client.Widgets.GetFrame(context.Background(), "board", "1")
+	if err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if diff := cmp.Diff(err.Error(), "widget 1 is not a frame, got:sticker"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
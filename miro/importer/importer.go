@@ -0,0 +1,512 @@
+// Package importer imports the rows of CSV and XLSX spreadsheets to a Miro board as stickers or cards.
+//
+// The columns of the rows are mapped to the members of the widgets, which are placed in a grid
+// or in a column per group. Rows with a key column update the widgets imported before, so that
+// a spreadsheet can be imported again after it changed.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/layout"
+)
+
+const (
+	defaultTagSeparator = ","
+	defaultDateLayout   = "2006-01-02"
+	defaultGap          = 20
+)
+
+var (
+	defaultStickerSize = layout.Size{Width: 199, Height: 228}
+	defaultCardSize    = layout.Size{Width: 320, Height: 94}
+
+	// excelEpoch is the day zero of the serial dates of spreadsheets after 1900-02-28,
+	// as spreadsheets count the day 1900-02-29 that does not exist.
+	excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+)
+
+// Mapping maps the columns of a table to the members of the widgets by column name.
+// Empty names leave the members empty.
+type Mapping struct {
+	// Key is the column identifying the rows, to update the widgets imported before.
+	Key string
+
+	// Text is the column of the text of stickers and the title of cards.
+	Text string
+
+	// Description is the column of the description of cards.
+	Description string
+
+	// Color is the column of the background color.
+	Color string
+
+	// Tags is the column of the titles of the tags, separated by TagSeparator.
+	Tags string
+
+	// Assignee is the column of the User ID cards are assigned to.
+	Assignee string
+
+	// DueDate is the column of the due date of cards.
+	DueDate string
+}
+
+// Options specifies the parameters of the import.
+type Options struct {
+	// Type is the type of the widgets, WidgetTypeSticker or WidgetTypeCard. Empty imports stickers.
+	Type miro.WidgetType
+
+	Mapping Mapping
+
+	// TagSeparator separates the tags in the tags column. Empty uses a comma.
+	TagSeparator string
+
+	// TagColors are the colors of the tags the import creates, by title ignoring case like the tags are matched.
+	// Tags missing from it are created without color.
+	TagColors map[string]miro.TagColor
+
+	// DateLayout is the layout of the due dates for time.Parse. Empty uses 2006-01-02.
+	// Numbers are parsed as the serial dates of spreadsheets.
+	DateLayout string
+
+	// Colors maps the values of the color column to colors, such as "high" to "#f24726".
+	// Values missing from it are used as colors.
+	Colors map[string]string
+
+	// GroupBy is the column to place the widgets in a column per value, like a Kanban board.
+	// Empty places the widgets in a grid.
+	GroupBy string
+
+	// Columns is the number of columns of the grid. Zero makes the grid as square as possible.
+	Columns int
+
+	// Gap is the space between the widgets. Zero uses 20.
+	Gap float64
+
+	// Origin is the position of the top left corner of the widgets.
+	Origin miro.Point
+
+	// Size is the size of the widgets for the layout. Zero uses the default size of the type.
+	Size layout.Size
+
+	// AppID is the ID of the app holding the key in the metadata of the widgets.
+	// It is required to update the widgets by key.
+	AppID string
+
+	// Bulk specifies the bulk operations creating and updating the widgets.
+	Bulk *miro.BulkOptions
+}
+
+// Record represents a row of the table imported as a widget.
+type Record struct {
+	// Row is the index of the row in Table.Rows.
+	Row int
+
+	// Key is the value of the key column.
+	Key string
+
+	// Widget is the widget to import, or the widget returned by Miro once imported.
+	Widget miro.Widget
+
+	// Tags are the titles in the tags column, attached to the widget once imported.
+	// The tags attached are then added to the tags of Widget.
+	Tags []string
+
+	// Update reports whether the record updates a widget imported before.
+	Update bool
+
+	// Err is the error reading the row or importing the widget.
+	Err error
+}
+
+// Failed returns the records with an error, to import again only the rows that failed.
+func Failed(records []*Record) []*Record {
+	failed := []*Record{}
+	for _, r := range records {
+		if r.Err != nil {
+			failed = append(failed, r)
+		}
+	}
+
+	return failed
+}
+
+// Importer imports tables to boards.
+type Importer struct {
+	client *miro.Client
+}
+
+// NewImporter returns a new importer.
+func NewImporter(client *miro.Client) *Importer {
+	return &Importer{client: client}
+}
+
+// Import imports the rows of the table to the board.
+// Rows whose key matches a widget imported before update it in place, the other rows create widgets
+// at their position in the layout. Every row is imported even if some fail, the errors are in the records.
+// The returned error is only set when the import could not start.
+func (i *Importer) Import(ctx context.Context, boardID string, t *Table, opt Options) ([]*Record, error) {
+	if opt.Mapping.Key != "" && opt.AppID == "" {
+		return nil, errors.New("app ID is required to update widgets by key")
+	}
+
+	records, err := Records(t, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Mapping.Key != "" {
+		existing, _, err := i.client.Widgets.List(ctx, boardID, widgetType(opt))
+		if err != nil {
+			return nil, err
+		}
+
+		index := keyIndex(existing, opt.AppID)
+		for _, r := range records {
+			if w, ok := index[r.Key]; ok && r.Err == nil && r.Key != "" {
+				b := r.Widget.Base()
+				b.ID, b.X, b.Y = w.Base().ID, w.Base().X, w.Base().Y
+				r.Update = true
+			}
+		}
+	}
+
+	creates, updates := []*Record{}, []*Record{}
+	for _, r := range records {
+		switch {
+		case r.Err != nil:
+		case r.Update:
+			updates = append(updates, r)
+		default:
+			creates = append(creates, r)
+		}
+	}
+
+	results, _ := i.client.Widgets.CreateMany(ctx, boardID, widgets(creates), opt.Bulk)
+	apply(creates, results)
+
+	results, _ = i.client.Widgets.UpdateMany(ctx, boardID, widgets(updates), opt.Bulk)
+	apply(updates, results)
+
+	i.attachTags(ctx, boardID, records, opt)
+
+	return records, nil
+}
+
+// attachTags attaches the tags to the widgets imported, creating the tags missing from the board.
+// Tags are matched by title ignoring case. Tags removed from a row stay attached to the widget it updates.
+func (i *Importer) attachTags(ctx context.Context, boardID string, records []*Record, opt Options) {
+	titles := []string{}
+	byTitle := map[string][]*Record{}
+	for _, r := range records {
+		if r.Err != nil || r.Widget.Base().ID == "" {
+			continue
+		}
+
+		for _, t := range r.Tags {
+			key := strings.ToLower(t)
+			if _, ok := byTitle[key]; !ok {
+				titles = append(titles, t)
+			}
+			byTitle[key] = append(byTitle[key], r)
+		}
+	}
+
+	if len(titles) == 0 {
+		return
+	}
+
+	fail := func(records []*Record, err error) {
+		for _, r := range records {
+			if r.Err == nil {
+				r.Err = err
+			}
+		}
+	}
+
+	existing, _, err := i.client.Tags.List(ctx, boardID)
+	if err != nil {
+		for _, rs := range byTitle {
+			fail(rs, err)
+		}
+		return
+	}
+
+	tagIDs := map[string]string{}
+	for _, t := range existing {
+		tagIDs[strings.ToLower(t.Title)] = t.ID
+	}
+
+	colors := make(map[string]miro.TagColor, len(opt.TagColors))
+	for title, c := range opt.TagColors {
+		colors[strings.ToLower(title)] = c
+	}
+
+	for _, title := range titles {
+		rs := byTitle[strings.ToLower(title)]
+		ids := make([]string, len(rs))
+		for j, r := range rs {
+			ids[j] = r.Widget.Base().ID
+		}
+
+		var tag *miro.Tag
+		var err error
+		if id, ok := tagIDs[strings.ToLower(title)]; ok {
+			tag, _, err = i.client.Tags.Attach(ctx, boardID, id, ids...)
+		} else {
+			tag, _, err = i.client.Tags.Create(ctx, boardID, &miro.TagRequest{Title: title, Color: colors[strings.ToLower(title)], WidgetIDs: ids})
+		}
+
+		if err != nil {
+			fail(rs, fmt.Errorf("failed to attach tag %s: %w", title, err))
+			continue
+		}
+
+		for _, r := range rs {
+			addTag(r.Widget, &miro.Tag{ID: tag.ID, Title: tag.Title, Color: tag.Color})
+		}
+	}
+}
+
+// addTag adds the tag attached to the widget to its tags, as Miro does not return them on creation.
+func addTag(w miro.Widget, t *miro.Tag) {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		w.Tags = append(w.Tags, t)
+	case *miro.Card:
+		w.Tags = append(w.Tags, t)
+	}
+}
+
+// Records reads the rows of the table as widgets placed in the layout, without calling Miro.
+// Rows that cannot be read have Err set and are left out of the layout.
+func Records(t *Table, opt Options) ([]*Record, error) {
+	typ := widgetType(opt)
+	if typ != miro.WidgetTypeSticker && typ != miro.WidgetTypeCard {
+		return nil, fmt.Errorf("widget type not supported, got:%s", typ)
+	}
+
+	cols := map[string]int{}
+	for _, name := range []string{
+		opt.Mapping.Key, opt.Mapping.Text, opt.Mapping.Description, opt.Mapping.Color,
+		opt.Mapping.Tags, opt.Mapping.Assignee, opt.Mapping.DueDate, opt.GroupBy,
+	} {
+		if name == "" {
+			continue
+		}
+
+		col := t.Column(name)
+		if col < 0 {
+			return nil, fmt.Errorf("column not found: %q", name)
+		}
+		cols[name] = col
+	}
+
+	value := func(row []string, name string) string {
+		if name == "" {
+			return ""
+		}
+		return t.value(row, cols[name])
+	}
+
+	size := opt.Size
+	if size.Width <= 0 || size.Height <= 0 {
+		size = defaultStickerSize
+		if typ == miro.WidgetTypeCard {
+			size = defaultCardSize
+		}
+	}
+
+	records := make([]*Record, 0, len(t.Rows))
+	nodes := []layout.Node{}
+	for n, row := range t.Rows {
+		r := &Record{
+			Row:  n,
+			Key:  value(row, opt.Mapping.Key),
+			Tags: splitTags(value(row, opt.Mapping.Tags), opt.TagSeparator),
+		}
+		r.Widget, r.Err = newWidget(typ, row, value, opt)
+		records = append(records, r)
+
+		if r.Err == nil {
+			nodes = append(nodes, layout.Node{ID: strconv.Itoa(n), Size: size, Group: value(row, opt.GroupBy)})
+		}
+	}
+
+	gap := opt.Gap
+	if gap == 0 {
+		gap = defaultGap
+	}
+
+	origin := layout.Point{X: opt.Origin.X, Y: opt.Origin.Y}
+	var pos layout.Positions
+	if opt.GroupBy != "" {
+		pos = layout.Columns(nodes, layout.ColumnsOptions{Gap: gap, ColumnGap: gap, Origin: origin})
+	} else {
+		pos = layout.Grid(nodes, layout.GridOptions{Columns: opt.Columns, Gap: gap, Origin: origin})
+	}
+
+	for _, r := range records {
+		if p, ok := pos[strconv.Itoa(r.Row)]; ok {
+			b := r.Widget.Base()
+			b.X, b.Y = p.X, p.Y
+		}
+	}
+
+	return records, nil
+}
+
+// newWidget returns the widget of the type with the members mapped from the row.
+func newWidget(typ miro.WidgetType, row []string, value func([]string, string) string, opt Options) (miro.Widget, error) {
+	m := opt.Mapping
+
+	var style *miro.WidgetStyle
+	if c := value(row, m.Color); c != "" {
+		if mapped, ok := opt.Colors[c]; ok {
+			c = mapped
+		}
+		style = &miro.WidgetStyle{BackgroundColor: c}
+	}
+
+	var tags []*miro.Tag
+	for _, title := range splitTags(value(row, m.Tags), opt.TagSeparator) {
+		tags = append(tags, &miro.Tag{Title: title})
+	}
+
+	var metadata map[string]json.RawMessage
+	if key := value(row, m.Key); key != "" && opt.AppID != "" {
+		b, err := json.Marshal(map[string]string{"key": key})
+		if err != nil {
+			return nil, err
+		}
+		metadata = map[string]json.RawMessage{opt.AppID: b}
+	}
+
+	if typ == miro.WidgetTypeSticker {
+		return &miro.Sticker{
+			WidgetBase: miro.WidgetBase{Type: typ, Metadata: metadata},
+			Text:       value(row, m.Text),
+			Style:      style,
+			Tags:       tags,
+		}, nil
+	}
+
+	card := &miro.Card{
+		WidgetBase:  miro.WidgetBase{Type: typ, Metadata: metadata},
+		Title:       value(row, m.Text),
+		Description: value(row, m.Description),
+		Style:       style,
+		Tags:        tags,
+	}
+
+	if a := value(row, m.Assignee); a != "" {
+		card.Assignee = &miro.CardAssignee{UserID: a}
+	}
+
+	if d := value(row, m.DueDate); d != "" {
+		due, err := parseDate(d, opt.DateLayout)
+		if err != nil {
+			return nil, err
+		}
+		card.DueDate = &due
+	}
+
+	return card, nil
+}
+
+// parseDate parses the date with the layout, or as the serial date of spreadsheets if it is a number.
+func parseDate(s, layout string) (time.Time, error) {
+	if layout == "" {
+		layout = defaultDateLayout
+	}
+
+	t, err := time.Parse(layout, s)
+	if err == nil {
+		return t, nil
+	}
+
+	serial, serr := strconv.ParseFloat(s, 64)
+	if serr != nil || serial < 1 {
+		return time.Time{}, fmt.Errorf("invalid due date: %w", err)
+	}
+
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * 24 * 60 * 60)
+	return excelEpoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second), nil
+}
+
+func splitTags(s, sep string) []string {
+	if sep == "" {
+		sep = defaultTagSeparator
+	}
+
+	tags := []string{}
+	for _, t := range strings.Split(s, sep) {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+
+	return tags
+}
+
+func widgetType(opt Options) miro.WidgetType {
+	if opt.Type == "" {
+		return miro.WidgetTypeSticker
+	}
+
+	return opt.Type
+}
+
+// keyIndex returns the widgets by the key in their metadata of the app.
+func keyIndex(widgets []miro.Widget, appID string) map[string]miro.Widget {
+	index := map[string]miro.Widget{}
+	for _, w := range widgets {
+		raw, ok := w.Base().Metadata[appID]
+		if !ok {
+			continue
+		}
+
+		meta := struct {
+			Key string `json:"key"`
+		}{}
+		if err := json.Unmarshal(raw, &meta); err != nil || meta.Key == "" {
+			continue
+		}
+
+		index[meta.Key] = w
+	}
+
+	return index
+}
+
+func widgets(records []*Record) []miro.Widget {
+	ws := make([]miro.Widget, len(records))
+	for i, r := range records {
+		ws[i] = r.Widget
+	}
+
+	return ws
+}
+
+// apply sets the results of the bulk operation to the records in the same order.
+func apply(records []*Record, results miro.BulkResults) {
+	for i, res := range results {
+		if res.Err != nil {
+			records[i].Err = res.Err
+			continue
+		}
+
+		if res.Widget != nil {
+			records[i].Widget = res.Widget
+		}
+	}
+}
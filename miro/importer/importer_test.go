@@ -0,0 +1,358 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/Miro-Ecosystem/go-miro/miro/layout"
+	"github.com/google/go-cmp/cmp"
+)
+
+const backlog = "\ufeffID,Title,Details,Priority,Labels,Owner,Due,Status\n" +
+	"1,Login,Users sign in,high,\"auth, web\",alice,2022-03-01,todo\n" +
+	"\n" +
+	"2,Logout,,low,,,44621,doing\n" +
+	"3,Export,Export boards,high,,bob,someday,todo\n"
+
+func TestReadCSV(t *testing.T) {
+	tcs := map[string]struct {
+		in      string
+		want    *Table
+		wantErr error
+	}{
+		"ok":    {"a,b\n1\n\n2,3,4\n", &Table{Header: []string{"a", "b"}, Rows: [][]string{{"1"}, {"2", "3", "4"}}}, nil},
+		"bom":   {"\ufeffa\n1\n", &Table{Header: []string{"a"}, Rows: [][]string{{"1"}}}, nil},
+		"empty": {"\n", nil, ErrEmptyTable},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tc.in))
+			if err != tc.wantErr {
+				t.Fatalf("error not expected, got:%v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestRecords(t *testing.T) {
+	table, err := ReadCSV(strings.NewReader(backlog))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	t.Run("stickers in grid", func(t *testing.T) {
+		got, err := Records(table, Options{
+			Mapping: Mapping{Text: "title", Color: "Priority", Tags: "Labels"},
+			Colors:  map[string]string{"high": "#f24726"},
+			Columns: 2,
+			Size:    layout.Size{Width: 100, Height: 100},
+		})
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		want := []miro.Widget{
+			&miro.Sticker{
+				WidgetBase: miro.WidgetBase{Type: miro.WidgetTypeSticker, X: 50, Y: 50},
+				Text:       "Login",
+				Style:      &miro.WidgetStyle{BackgroundColor: "#f24726"},
+				Tags:       []*miro.Tag{{Title: "auth"}, {Title: "web"}},
+			},
+			&miro.Sticker{
+				WidgetBase: miro.WidgetBase{Type: miro.WidgetTypeSticker, X: 170, Y: 50},
+				Text:       "Logout",
+				Style:      &miro.WidgetStyle{BackgroundColor: "low"},
+			},
+			&miro.Sticker{
+				WidgetBase: miro.WidgetBase{Type: miro.WidgetTypeSticker, X: 50, Y: 170},
+				Text:       "Export",
+				Style:      &miro.WidgetStyle{BackgroundColor: "#f24726"},
+			},
+		}
+
+		if diff := cmp.Diff(widgetsOf(got), want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(got[0].Tags, []string{"auth", "web"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("cards by group", func(t *testing.T) {
+		got, err := Records(table, Options{
+			Type:    miro.WidgetTypeCard,
+			Mapping: Mapping{Key: "ID", Text: "Title", Description: "Details", Assignee: "Owner", DueDate: "Due"},
+			GroupBy: "Status",
+			Gap:     10,
+			Size:    layout.Size{Width: 100, Height: 50},
+			AppID:   "app",
+		})
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		due := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+		want := []miro.Widget{
+			&miro.Card{
+				WidgetBase:  miro.WidgetBase{Type: miro.WidgetTypeCard, X: 50, Y: 25, Metadata: keyMetadata("1")},
+				Title:       "Login",
+				Description: "Users sign in",
+				Assignee:    &miro.CardAssignee{UserID: "alice"},
+				DueDate:     &due,
+			},
+			&miro.Card{
+				WidgetBase: miro.WidgetBase{Type: miro.WidgetTypeCard, X: 160, Y: 25, Metadata: keyMetadata("2")},
+				Title:      "Logout",
+				DueDate:    &due,
+			},
+			nil,
+		}
+
+		if diff := cmp.Diff(widgetsOf(got), want); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if got[2].Err == nil || got[2].Key != "3" {
+			t.Fatalf("record not expected, got:%+v", got[2])
+		}
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		if _, err := Records(table, Options{Mapping: Mapping{Text: "Name"}}); err == nil {
+			t.Fatalf("Should failed")
+		}
+	})
+}
+
+func TestImporter_Import(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	table, err := ReadCSV(strings.NewReader("Key,Text\n1,updated\n2,new\n3,fail\n"))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mux.HandleFunc("/boards/board/widgets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"type": "collection", "data": [
+	{"id": "w1", "type": "sticker", "x": -500, "y": 500, "text": "old", "metadata": {"app": {"key": "1"}}},
+	{"id": "w9", "type": "sticker", "text": "manual"}
+]}`)
+		case http.MethodPost:
+			s := &miro.Sticker{}
+			if err := json.NewDecoder(r.Body).Decode(s); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if s.Text == "fail" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"status": 400, "message": "invalid"}`)
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "w2", "type": "sticker", "text": "new"}`)
+		}
+	})
+
+	var mu sync.Mutex
+	patched := ""
+	mux.HandleFunc("/boards/board/widgets/w1", func(w http.ResponseWriter, r *http.Request) {
+		var got map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		mu.Lock()
+		patched = string(got["text"]) + " " + string(got["x"]) + " " + string(got["y"])
+		mu.Unlock()
+
+		fmt.Fprint(w, `{"id": "w1", "type": "sticker", "text": "updated"}`)
+	})
+
+	got, err := NewImporter(client).Import(context.Background(), "board", table, Options{
+		Mapping: Mapping{Key: "Key", Text: "Text"},
+		AppID:   "app",
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(patched, `"updated" -500 500`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	ids := []string{}
+	for _, r := range got {
+		if r.Err != nil {
+			ids = append(ids, "error")
+			continue
+		}
+		ids = append(ids, r.Widget.Base().ID)
+	}
+
+	if diff := cmp.Diff(ids, []string{"w1", "w2", "error"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(len(Failed(got)), 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if !got[0].Update || got[1].Update {
+		t.Fatalf("updates not expected, got:%v, %v", got[0].Update, got[1].Update)
+	}
+}
+
+func TestImporter_Import_Tags(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	table, err := ReadCSV(strings.NewReader("Text,Tags\na,\"Retro, keep\"\nb,retro\nfail,retro\n"))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mux.HandleFunc("/boards/board/widgets", func(w http.ResponseWriter, r *http.Request) {
+		s := &miro.Sticker{}
+		if err := json.NewDecoder(r.Body).Decode(s); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if s.Text == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status": 400, "message": "invalid"}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": "w%s", "type": "sticker", "text": "%s"}`, s.Text, s.Text)
+	})
+
+	mux.HandleFunc("/boards/board/tags", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"type": "collection", "data": [{"id": "t1", "title": "retro", "widgetIds": ["w0"]}]}`)
+		case http.MethodPost:
+			b, _ := io.ReadAll(r.Body)
+			if diff := cmp.Diff(strings.TrimSpace(string(b)), `{"title":"keep","color":"green","widgetIds":["wa"]}`); diff != "" {
+				t.Errorf("Diff: %s(-got +want)", diff)
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "t2", "title": "keep", "color": "green", "widgetIds": ["wa"]}`)
+		}
+	})
+
+	var mu sync.Mutex
+	attached := ""
+	mux.HandleFunc("/boards/board/tags/t1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id": "t1", "title": "retro", "widgetIds": ["w0"]}`)
+		case http.MethodPatch:
+			b, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			attached = string(b)
+			mu.Unlock()
+
+			fmt.Fprint(w, `{"id": "t1", "title": "retro"}`)
+		}
+	})
+
+	got, err := NewImporter(client).Import(context.Background(), "board", table, Options{
+		Mapping:   Mapping{Text: "Text", Tags: "Tags"},
+		TagColors: map[string]miro.TagColor{"Keep": miro.TagColorGreen},
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(strings.TrimSpace(attached), `{"widgetIds":["w0","wa","wb"]}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(len(Failed(got)), 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	wantTags := map[string][]*miro.Tag{
+		"wa": {{ID: "t1", Title: "retro"}, {ID: "t2", Title: "keep", Color: miro.TagColorGreen}},
+		"wb": {{ID: "t1", Title: "retro"}},
+	}
+	for _, r := range got {
+		if r.Err != nil {
+			continue
+		}
+
+		s := r.Widget.(*miro.Sticker)
+
+		if diff := cmp.Diff(s.Tags, wantTags[s.ID]); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	}
+}
+
+func TestImporter_Import_KeyWithoutAppID(t *testing.T) {
+	table := &Table{Header: []string{"Key"}}
+	if _, err := NewImporter(miro.NewClient("miro-test")).Import(context.Background(), "board", table, Options{
+		Mapping: Mapping{Key: "Key"},
+	}); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tcs := map[string]struct {
+		in     string
+		layout string
+		want   time.Time
+	}{
+		"layout":      {"01/03/2022", "02/01/2006", time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		"serial":      {"44621", "", time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+		"serial time": {"44621.5", "", time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := parseDate(tc.in, tc.layout)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func widgetsOf(records []*Record) []miro.Widget {
+	ws := make([]miro.Widget, len(records))
+	for i, r := range records {
+		ws[i] = r.Widget
+	}
+
+	return ws
+}
+
+func keyMetadata(key string) map[string]json.RawMessage {
+	return map[string]json.RawMessage{"app": json.RawMessage(fmt.Sprintf(`{"key":"%s"}`, key))}
+}
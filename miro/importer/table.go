@@ -0,0 +1,83 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrEmptyTable is returned when a table has no header.
+var ErrEmptyTable = errors.New("empty table")
+
+// Table represents the rows of a spreadsheet under its header.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// Column returns the index of the column by its name in the header, -1 if missing.
+// Names are compared ignoring case and surrounding spaces.
+func (t *Table) Column(name string) int {
+	name = strings.TrimSpace(name)
+	for i, h := range t.Header {
+		if strings.EqualFold(strings.TrimSpace(h), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// value returns the trimmed value of the row in the column, empty when the row is shorter.
+func (t *Table) value(row []string, col int) string {
+	if col < 0 || col >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[col])
+}
+
+// ReadCSV reads a table from CSV with the header on the first line.
+// Rows may have a different number of fields than the header.
+func ReadCSV(r io.Reader) (*Table, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return newTable(records)
+}
+
+// newTable returns the table of the records with the header first, skipping the empty rows.
+func newTable(records [][]string) (*Table, error) {
+	rows := [][]string{}
+	for _, r := range records {
+		if !isEmptyRow(r) {
+			rows = append(rows, r)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmptyTable
+	}
+
+	header := rows[0]
+	// Spreadsheet applications often write a byte order mark.
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	return &Table{Header: header, Rows: rows[1:]}, nil
+}
+
+func isEmptyRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+
+	return true
+}
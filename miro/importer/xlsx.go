@@ -0,0 +1,228 @@
+package importer
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+
+	// xlsxMaxColumns is the number of columns of a sheet, XFD being the last one.
+	xlsxMaxColumns       = 16384
+	xlsxMaxColumnLetters = 3
+)
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxString represents a shared or inline string, either plain or rich text made of runs.
+type xlsxString struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (s xlsxString) String() string {
+	if len(s.R) == 0 {
+		return s.T
+	}
+
+	b := strings.Builder{}
+	for _, r := range s.R {
+		b.WriteString(r.T)
+	}
+
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxString `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string      `xml:"r,attr"`
+			Type   string      `xml:"t,attr"`
+			Value  string      `xml:"v"`
+			Inline *xlsxString `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX reads a table from the sheet of an XLSX workbook with the header on the first row.
+// An empty sheet name reads the first sheet.
+//
+// Only the values of the cells are read. Formulas are read as their cached results
+// and dates as their serial numbers, which Import parses as due dates.
+func ReadXLSX(r io.ReaderAt, size int64, sheet string) (*Table, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := xlsxSheetPath(files, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := &xlsxSharedStrings{}
+	if f, ok := files[sharedStringsPath]; ok {
+		if err := decodeXML(f, shared); err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("sheet not found in workbook: %s", sheetPath)
+	}
+
+	ws := &xlsxWorksheet{}
+	if err := decodeXML(f, ws); err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, 0, len(ws.Rows))
+	for _, row := range ws.Rows {
+		record := []string{}
+		col := -1
+		for _, c := range row.Cells {
+			// Cells without a reference follow the previous cell.
+			col++
+			if c.Ref != "" {
+				if col, err = xlsxColumn(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+
+			for len(record) <= col {
+				record = append(record, "")
+			}
+
+			switch c.Type {
+			case "s":
+				n, err := strconv.Atoi(c.Value)
+				if err != nil || n < 0 || n >= len(shared.Items) {
+					return nil, fmt.Errorf("invalid shared string in cell %s: %q", c.Ref, c.Value)
+				}
+				record[col] = shared.Items[n].String()
+			case "inlineStr":
+				if c.Inline != nil {
+					record[col] = c.Inline.String()
+				}
+			case "b":
+				record[col] = strconv.FormatBool(c.Value == "1")
+			default:
+				record[col] = c.Value
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return newTable(records)
+}
+
+// xlsxSheetPath returns the path in the archive of the sheet by name, the first sheet when name is empty.
+func xlsxSheetPath(files map[string]*zip.File, name string) (string, error) {
+	f, ok := files[workbookPath]
+	if !ok {
+		return "", fmt.Errorf("not an XLSX workbook: %s not found", workbookPath)
+	}
+
+	wb := &xlsxWorkbook{}
+	if err := decodeXML(f, wb); err != nil {
+		return "", err
+	}
+
+	rid := ""
+	for _, s := range wb.Sheets {
+		if name == "" || s.Name == name {
+			rid = s.RID
+			break
+		}
+	}
+
+	if rid == "" {
+		return "", fmt.Errorf("sheet not found in workbook: %q", name)
+	}
+
+	f, ok = files[workbookRelsPath]
+	if !ok {
+		return "", fmt.Errorf("not an XLSX workbook: %s not found", workbookRelsPath)
+	}
+
+	rels := &xlsxRelationships{}
+	if err := decodeXML(f, rels); err != nil {
+		return "", err
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != rid {
+			continue
+		}
+
+		// Targets are relative to the workbook unless absolute in the archive.
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join(path.Dir(workbookPath), rel.Target), nil
+	}
+
+	return "", fmt.Errorf("sheet not found in workbook: %q", name)
+}
+
+// xlsxColumn returns the zero based column of a cell reference such as "AB12".
+// The columns past XFD, the last column of Excel, are rejected.
+func xlsxColumn(ref string) (int, error) {
+	col := 0
+	i := 0
+	for ; i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z'; i++ {
+		if i == xlsxMaxColumnLetters {
+			return 0, fmt.Errorf("invalid cell reference: %q", ref)
+		}
+		col = col*26 + int(ref[i]-'A'+1)
+	}
+
+	if i == 0 || col > xlsxMaxColumns {
+		return 0, fmt.Errorf("invalid cell reference: %q", ref)
+	}
+
+	return col - 1, nil
+}
+
+func decodeXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return xml.NewDecoder(rc).Decode(v)
+}
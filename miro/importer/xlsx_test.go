@@ -0,0 +1,154 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newXLSX(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadXLSX(t *testing.T) {
+	r := newXLSX(t, map[string]string{
+		workbookPath: `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+	<sheets>
+		<sheet name="Summary" sheetId="1" r:id="rId1"/>
+		<sheet name="Backlog" sheetId="2" r:id="rId2"/>
+	</sheets>
+</workbook>`,
+		workbookRelsPath: `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+	<Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/>
+	<Relationship Id="rId2" Type="worksheet" Target="/xl/worksheets/sheet2.xml"/>
+</Relationships>`,
+		sharedStringsPath: `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<si><t>Title</t></si>
+	<si><t>Due</t></si>
+	<si><r><t>Log</t></r><r><t>in</t></r></si>
+</sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>Total</t></is></c></row></sheetData>
+</worksheet>`,
+		"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<sheetData>
+		<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+		<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2" t="b"><v>1</v></c><c r="C2"><v>44621</v></c></row>
+		<row r="4"><c r="A4" t="inlineStr"><is><t>Logout</t></is></c></row>
+	</sheetData>
+</worksheet>`,
+	})
+
+	tcs := map[string]struct {
+		sheet string
+		want  *Table
+	}{
+		"first": {"", &Table{Header: []string{"Total"}, Rows: [][]string{}}},
+		"by name": {"Backlog", &Table{
+			Header: []string{"Title", "", "Due"},
+			Rows:   [][]string{{"Login", "true", "44621"}, {"Logout"}},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := ReadXLSX(r, r.Size(), tc.sheet)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+
+	if _, err := ReadXLSX(r, r.Size(), "Missing"); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
+
+func TestXLSXColumn(t *testing.T) {
+	tcs := map[string]int{"A1": 0, "C12": 2, "Z3": 25, "AA1": 26, "AB7": 27, "XFD1": 16383}
+	for ref, want := range tcs {
+		got, err := xlsxColumn(ref)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if got != want {
+			t.Fatalf("column of %s not expected, got:%d want:%d", ref, got, want)
+		}
+	}
+}
+
+func TestXLSXColumn_Invalid(t *testing.T) {
+	for _, ref := range []string{"1", "ZZZZZZZZZZZZZZ1", "AAAAAAA1", "XFE1"} {
+		if _, err := xlsxColumn(ref); err == nil {
+			t.Fatalf("Should failed: %s", ref)
+		}
+	}
+}
+
+func TestReadXLSX_Cells(t *testing.T) {
+	sheet := func(cells string) *bytes.Reader {
+		return newXLSX(t, map[string]string{
+			workbookPath: `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+	<sheets><sheet name="Sheet" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`,
+			workbookRelsPath: `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+	<Relationship Id="rId1" Type="worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+			"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+	<sheetData><row r="1">` + cells + `</row></sheetData>
+</worksheet>`,
+		})
+	}
+
+	r := sheet(`<c r="B1"><v>b</v></c><c><v>c</v></c><c r="E1"><v>e</v></c><c><v>f</v></c>`)
+	got, err := ReadXLSX(r, r.Size(), "")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got.Header, []string{"", "b", "c", "", "e", "f"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	for _, ref := range []string{"ZZZZZZZZZZZZZZ1", "AAAAAAA1"} {
+		r := sheet(`<c r="` + ref + `"><v>x</v></c>`)
+		if _, err := ReadXLSX(r, r.Size(), ""); err == nil || !strings.Contains(err.Error(), "invalid cell reference") {
+			t.Fatalf("Should failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Package mirotest provides a fake Miro API for the tests of the packages built on the client.
+package mirotest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const (
+	baseURLPath = "/v1"
+)
+
+// Setup returns a client whose requests are served by the returned mux, with the paths of the API,
+// and a function closing the server.
+func Setup() (*miro.Client, *http.ServeMux, func()) {
+	mux := http.NewServeMux()
+
+	apiHandler := http.NewServeMux()
+	apiHandler.Handle(baseURLPath+"/", http.StripPrefix(baseURLPath, mux))
+	server := httptest.NewServer(apiHandler)
+	client := miro.NewClient("miro-test")
+	url, _ := url.Parse(server.URL + baseURLPath)
+	client.BaseURL = url
+	return client, mux, server.Close
+}
+
+// SetupBoard returns a client whose requests to the widgets of the board are served by a fake board,
+// and a function closing the server.
+func SetupBoard(boardID string) (*miro.Client, *Board, func()) {
+	client, mux, teardown := Setup()
+
+	b := NewBoard(boardID)
+	mux.Handle(b.path, b)
+	mux.Handle(b.path+"/", b)
+	return client, b, teardown
+}
+
+// Board serves the widgets of a board from memory, merging the members of updates.
+// The created widgets are given the IDs of their type followed by a counter, card1, frame2...
+type Board struct {
+	mu      sync.Mutex
+	path    string
+	order   []string
+	widgets map[string]map[string]interface{}
+	next    int
+
+	// fail are the IDs of the widgets whose updates fail.
+	fail map[string]bool
+}
+
+// NewBoard returns an empty board serving the widgets path of the board.
+func NewBoard(boardID string) *Board {
+	return &Board{
+		path:    fmt.Sprintf("/boards/%s/widgets", boardID),
+		widgets: map[string]map[string]interface{}{},
+	}
+}
+
+// Add adds the widget of the JSON object raw to the board. It panics if raw is not valid.
+func (b *Board) Add(raw string) {
+	w := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(raw), &w); err != nil {
+		panic(err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := w["id"].(string)
+	b.order = append(b.order, id)
+	b.widgets[id] = w
+}
+
+// Has reports whether the widget is on the board.
+func (b *Board) Has(id string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.widgets[id]
+	return ok
+}
+
+// Get returns a member of the widget.
+func (b *Board) Get(id, member string) interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.widgets[id][member]
+}
+
+// Set changes a member of the widget, as if it was changed on the board.
+func (b *Board) Set(id, member string, value interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.widgets[id][member] = value
+}
+
+// Fail makes the updates of the widgets fail, replacing the widgets of the previous calls.
+// Calling it without IDs makes the updates succeed again.
+func (b *Board) Fail(ids ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.fail = map[string]bool{}
+	for _, id := range ids {
+		b.fail[id] = true
+	}
+}
+
+func (b *Board) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, b.path)
+	id = strings.TrimPrefix(id, "/")
+
+	body := map[string]interface{}{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		typ := r.URL.Query().Get("widgetType")
+		data := []interface{}{}
+		for _, id := range b.order {
+			if typ == "" || b.widgets[id]["type"] == typ {
+				data = append(data, b.widgets[id])
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"type": "collection", "data": data})
+	case id == "" && r.Method == http.MethodPost:
+		b.next++
+		body["id"] = fmt.Sprintf("%s%d", body["type"], b.next)
+		b.order = append(b.order, body["id"].(string))
+		b.widgets[body["id"].(string)] = body
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(body)
+	case r.Method == http.MethodPatch && b.fail[id]:
+		w.WriteHeader(http.StatusInternalServerError)
+	case r.Method == http.MethodPatch:
+		for k, v := range body {
+			b.widgets[id][k] = v
+		}
+		json.NewEncoder(w).Encode(b.widgets[id])
+	default:
+		json.NewEncoder(w).Encode(b.widgets[id])
+	}
+}
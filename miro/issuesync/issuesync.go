@@ -0,0 +1,468 @@
+// Package issuesync mirrors the issues of an issue tracker on the cards of a Miro board, both ways.
+//
+// The title, description, assignee and due date of the issues are copied to the cards, and
+// their status places the cards in a Kanban column or colors them. Cards moved to another
+// column or colored differently change the status of their issue. A Store keeps the links
+// between issues and cards, and when both sides changed since the last sync the side
+// modified last wins.
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/kanban"
+)
+
+const (
+	defaultCardWidth  = 320
+	defaultCardHeight = 94
+	defaultGap        = 20
+)
+
+// Options specifies the parameters of the sync.
+type Options struct {
+	// Colors maps the statuses to the background colors of the cards, the issues of other statuses
+	// failing the sync. A color of several statuses reads back as the first of them in alphabetical order.
+	// Empty places the cards in the Kanban column titled as their status instead.
+	Colors map[string]string
+
+	// Kanban specifies the Kanban board of the columns, when Colors is empty.
+	Kanban kanban.Options
+
+	// Origin is the position of the top left corner of the cards created when Colors is set.
+	// Cards are created below the other cards.
+	Origin miro.Point
+
+	// Users maps the assignees of the issues to Miro User IDs.
+	// Assignees missing from it are used as User IDs.
+	Users map[string]string
+}
+
+// Report represents the changes of a sync by Issue ID.
+type Report struct {
+	// Created are the issues mirrored on new cards.
+	Created []string
+
+	// Updated are the issues whose card was updated.
+	Updated []string
+
+	// Pushed are the issues whose status was updated from their card.
+	Pushed []string
+
+	// Conflicts are the issues changed on both sides, resolved by modification time.
+	Conflicts []string
+
+	// Unlinked are the issues no longer listed by the source, whose cards are left on the board.
+	Unlinked []string
+}
+
+// Engine syncs the issues of a source with the cards of a board.
+type Engine struct {
+	client  *miro.Client
+	boardID string
+	source  IssueSource
+	store   Store
+	opt     Options
+}
+
+// NewEngine returns a new sync engine.
+func NewEngine(client *miro.Client, boardID string, source IssueSource, store Store, opt Options) *Engine {
+	return &Engine{
+		client:  client,
+		boardID: boardID,
+		source:  source,
+		store:   store,
+		opt:     opt,
+	}
+}
+
+// view is the representation of the statuses on the board.
+type view interface {
+	// cards returns the cards of the board by Widget ID.
+	cards() map[string]*miro.Card
+
+	// status returns the status of the card on the board, empty if it has none.
+	status(card *miro.Card) string
+
+	// create creates the card with the status.
+	create(ctx context.Context, card *miro.Card, status string) (*miro.Card, error)
+
+	// setStatus changes the status of the card.
+	setStatus(ctx context.Context, card *miro.Card, status string) error
+}
+
+// Sync mirrors the issues on the cards and pushes the status of the cards moved to their issues.
+// The links are saved even when an issue fails, so that the next sync resumes from there.
+func (e *Engine) Sync(ctx context.Context) (*Report, error) {
+	issues, err := e.source.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := e.store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := e.view(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	err = e.sync(ctx, v, issues, links, report)
+	if serr := e.store.Save(ctx, links); err == nil {
+		err = serr
+	}
+
+	return report, err
+}
+
+func (e *Engine) sync(ctx context.Context, v view, issues []*Issue, links map[string]*Link, report *Report) error {
+	cards := v.cards()
+	listed := map[string]bool{}
+
+	for _, issue := range issues {
+		listed[issue.ID] = true
+
+		link, ok := links[issue.ID]
+		var card *miro.Card
+		if ok {
+			card = cards[link.WidgetID]
+		}
+
+		if card == nil {
+			created, err := v.create(ctx, e.card(&miro.Card{}, issue), issue.Status)
+			if err != nil {
+				return err
+			}
+
+			links[issue.ID] = &Link{IssueID: issue.ID, WidgetID: created.ID, Status: issue.Status, IssueModifiedAt: issue.ModifiedAt}
+			report.Created = append(report.Created, issue.ID)
+			continue
+		}
+
+		status := v.status(card)
+		if status == "" {
+			status = link.Status
+		}
+
+		issueChanged := issue.ModifiedAt.After(link.IssueModifiedAt)
+		cardChanged := status != link.Status
+
+		pull := issueChanged
+		if issueChanged && cardChanged {
+			report.Conflicts = append(report.Conflicts, issue.ID)
+			pull = !card.ModifiedAt.After(issue.ModifiedAt)
+		}
+
+		switch {
+		case pull:
+			if err := e.pull(ctx, v, card, issue, status); err != nil {
+				return err
+			}
+
+			link.Status = issue.Status
+			link.IssueModifiedAt = issue.ModifiedAt
+			report.Updated = append(report.Updated, issue.ID)
+		case cardChanged:
+			// The card only changes the status, the other members changed on the issue are still pulled.
+			if issueChanged {
+				updated, err := e.updateCard(ctx, card, issue)
+				if err != nil {
+					return err
+				}
+				if updated {
+					report.Updated = append(report.Updated, issue.ID)
+				}
+			}
+
+			issue.Status = status
+			updated, err := e.source.Update(ctx, issue)
+			if err != nil {
+				return err
+			}
+
+			link.Status = status
+			link.IssueModifiedAt = updated.ModifiedAt
+			report.Pushed = append(report.Pushed, issue.ID)
+		}
+	}
+
+	ids := make([]string, 0, len(links))
+	for id := range links {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if !listed[id] {
+			delete(links, id)
+			report.Unlinked = append(report.Unlinked, id)
+		}
+	}
+
+	return nil
+}
+
+// pull updates the card from the issue, only when its members or its status changed.
+func (e *Engine) pull(ctx context.Context, v view, card *miro.Card, issue *Issue, status string) error {
+	if _, err := e.updateCard(ctx, card, issue); err != nil {
+		return err
+	}
+
+	if status != issue.Status {
+		return v.setStatus(ctx, card, issue.Status)
+	}
+
+	return nil
+}
+
+// updateCard updates the members of the card mirrored from the issue, leaving its status,
+// and reports whether they changed. The card is only changed in memory once updated on the board.
+func (e *Engine) updateCard(ctx context.Context, card *miro.Card, issue *Issue) (bool, error) {
+	updated := *card
+	e.card(&updated, issue)
+	if sameCard(card, &updated) {
+		return false, nil
+	}
+
+	if _, _, err := e.client.Widgets.Update(ctx, e.boardID, card.ID, &updated); err != nil {
+		return false, err
+	}
+
+	*card = updated
+	return true, nil
+}
+
+// card sets the members of the card mirrored from the issue.
+func (e *Engine) card(card *miro.Card, issue *Issue) *miro.Card {
+	card.Type = miro.WidgetTypeCard
+	card.Title = issue.Title
+	card.Description = issue.Description
+	card.DueDate = issue.DueDate
+	card.Assignee = nil
+	if issue.Assignee != "" {
+		id := issue.Assignee
+		if u, ok := e.opt.Users[id]; ok {
+			id = u
+		}
+		card.Assignee = &miro.CardAssignee{UserID: id}
+	}
+
+	return card
+}
+
+func sameCard(a, b *miro.Card) bool {
+	if a.Title != b.Title || a.Description != b.Description {
+		return false
+	}
+
+	if (a.Assignee == nil) != (b.Assignee == nil) || (a.Assignee != nil && a.Assignee.UserID != b.Assignee.UserID) {
+		return false
+	}
+
+	if (a.DueDate == nil) != (b.DueDate == nil) || (a.DueDate != nil && !a.DueDate.Equal(*b.DueDate)) {
+		return false
+	}
+
+	return true
+}
+
+func (e *Engine) view(ctx context.Context) (view, error) {
+	if len(e.opt.Colors) > 0 {
+		widgets, _, err := e.client.Widgets.List(ctx, e.boardID, miro.WidgetTypeCard)
+		if err != nil {
+			return nil, err
+		}
+
+		v := &colorView{engine: e, byID: map[string]*miro.Card{}, statuses: make([]string, 0, len(e.opt.Colors))}
+		for status := range e.opt.Colors {
+			v.statuses = append(v.statuses, status)
+		}
+		sort.Strings(v.statuses)
+
+		for _, w := range widgets {
+			if c, ok := w.(*miro.Card); ok {
+				v.byID[c.ID] = c
+			}
+		}
+
+		return v, nil
+	}
+
+	b, err := kanban.Open(ctx, e.client, e.boardID, e.opt.Kanban)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kanbanView{board: b}, nil
+}
+
+// kanbanView represents the statuses as the columns of a Kanban board.
+// Cards taken out of every column keep the status of the last sync.
+type kanbanView struct {
+	board *kanban.Board
+}
+
+func (v *kanbanView) cards() map[string]*miro.Card {
+	cards := map[string]*miro.Card{}
+	for _, c := range v.board.Columns() {
+		for _, card := range c.Cards {
+			cards[card.ID] = card
+		}
+	}
+
+	for _, card := range v.board.Loose() {
+		cards[card.ID] = card
+	}
+
+	return cards
+}
+
+func (v *kanbanView) status(card *miro.Card) string {
+	for _, c := range v.board.Columns() {
+		for _, cd := range c.Cards {
+			if cd.ID == card.ID {
+				return c.Title
+			}
+		}
+	}
+
+	return ""
+}
+
+func (v *kanbanView) create(ctx context.Context, card *miro.Card, status string) (*miro.Card, error) {
+	c, err := v.column(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.board.AddCard(ctx, c.ID, card)
+}
+
+func (v *kanbanView) setStatus(ctx context.Context, card *miro.Card, status string) error {
+	if v.status(card) == "" {
+		return nil
+	}
+
+	c, err := v.column(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	return v.board.MoveCard(ctx, card.ID, c.ID, -1)
+}
+
+// column returns the column titled as the status, adding it if missing.
+func (v *kanbanView) column(ctx context.Context, status string) (*kanban.Column, error) {
+	for _, c := range v.board.Columns() {
+		if c.Title == status {
+			return c, nil
+		}
+	}
+
+	return v.board.AddColumn(ctx, status)
+}
+
+// colorView represents the statuses as the background colors of the cards.
+type colorView struct {
+	engine *Engine
+	byID   map[string]*miro.Card
+
+	// statuses are the statuses of the colors sorted, the order their colors are looked up in.
+	statuses []string
+}
+
+func (v *colorView) cards() map[string]*miro.Card {
+	return v.byID
+}
+
+func (v *colorView) status(card *miro.Card) string {
+	if card.Style == nil {
+		return ""
+	}
+
+	for _, status := range v.statuses {
+		if strings.EqualFold(v.engine.opt.Colors[status], card.Style.BackgroundColor) {
+			return status
+		}
+	}
+
+	return ""
+}
+
+// color returns the background color of the status.
+func (v *colorView) color(status string) (string, error) {
+	color, ok := v.engine.opt.Colors[status]
+	if !ok {
+		return "", fmt.Errorf("no color for status %q", status)
+	}
+
+	return color, nil
+}
+
+func (v *colorView) create(ctx context.Context, card *miro.Card, status string) (*miro.Card, error) {
+	color, err := v.color(status)
+	if err != nil {
+		return nil, err
+	}
+	card.Style = &miro.WidgetStyle{BackgroundColor: color}
+
+	// New cards are stacked below the lowest card, so that they never cover the others.
+	top := v.engine.opt.Origin.Y
+	for _, c := range v.byID {
+		top = math.Max(top, c.Y+cardHeight(c)/2+defaultGap)
+	}
+	card.X = v.engine.opt.Origin.X + defaultCardWidth/2
+	card.Y = top + defaultCardHeight/2
+
+	w, _, err := v.engine.client.Widgets.Create(ctx, v.engine.boardID, card)
+	if err != nil {
+		return nil, err
+	}
+
+	created, ok := w.(*miro.Card)
+	if !ok {
+		return nil, fmt.Errorf("widget %s is not a card, got:%s", w.Base().ID, w.Base().Type)
+	}
+
+	v.byID[created.ID] = created
+	return created, nil
+}
+
+// setStatus changes the background color of the card, only changed in memory once updated on the board.
+func (v *colorView) setStatus(ctx context.Context, card *miro.Card, status string) error {
+	color, err := v.color(status)
+	if err != nil {
+		return err
+	}
+
+	style := miro.WidgetStyle{}
+	if card.Style != nil {
+		style = *card.Style
+	}
+	style.BackgroundColor = color
+
+	updated := *card
+	updated.Style = &style
+	if _, _, err := v.engine.client.Widgets.Update(ctx, v.engine.boardID, card.ID, &updated); err != nil {
+		return err
+	}
+
+	*card = updated
+	return nil
+}
+
+func cardHeight(c *miro.Card) float64 {
+	if c.Height > 0 {
+		return c.Height
+	}
+
+	return defaultCardHeight
+}
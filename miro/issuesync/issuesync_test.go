@@ -0,0 +1,263 @@
+package issuesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func at(hour int) time.Time {
+	return time.Date(2022, 3, 1, hour, 0, 0, 0, time.UTC)
+}
+
+func TestEngine_Sync_Kanban(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	due := at(0)
+	source := NewMemorySource(
+		&Issue{ID: "1", Title: "Login", Assignee: "alice", DueDate: &due, Status: "todo", ModifiedAt: at(1)},
+		&Issue{ID: "2", Title: "Logout", Status: "doing", ModifiedAt: at(1)},
+	)
+	source.Now = func() time.Time { return at(5) }
+	store := NewMemoryStore()
+	engine := NewEngine(client, "board", source, store, Options{Users: map[string]string{"alice": "u1"}})
+	ctx := context.Background()
+
+	got, err := engine.Sync(ctx)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, &Report{Created: []string{"1", "2"}}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	// Frames 1 and 3 are the columns, cards 2 and 4 the issues.
+	if diff := cmp.Diff([]interface{}{fake.Get("frame1", "title"), fake.Get("frame3", "title")}, []interface{}{"todo", "doing"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(fake.Get("card2", "assignee"), map[string]interface{}{"userId": "u1"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	t.Run("no change", func(t *testing.T) {
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("issue changed", func(t *testing.T) {
+		source.Put(&Issue{ID: "1", Title: "Sign in", Status: "doing", ModifiedAt: at(2)})
+
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{Updated: []string{"1"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(fake.Get("card2", "title"), "Sign in"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(fake.Get("frame3", "children"), []interface{}{"card4", "card2"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("card moved", func(t *testing.T) {
+		fake.Set("frame3", "children", []interface{}{"card2"})
+		fake.Set("frame1", "children", []interface{}{"card4"})
+
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{Pushed: []string{"2"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(source.Get("2").Status, "todo"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		// The issue changed at 6 and the card moved at 7, so the card wins.
+		source.Put(&Issue{ID: "2", Title: "Logout", Status: "done", ModifiedAt: at(6)})
+		fake.Set("frame1", "children", []interface{}{})
+		fake.Set("frame3", "children", []interface{}{"card2", "card4"})
+		fake.Set("card4", "modifiedAt", at(7).Format(time.RFC3339))
+
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{Pushed: []string{"2"}, Conflicts: []string{"2"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(source.Get("2").Status, "doing"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("conflict with issue edited", func(t *testing.T) {
+		// The issue was renamed at 8 and the card moved at 9, so the card wins the status
+		// but the title, only changed on the issue, is still pulled.
+		source.Put(&Issue{ID: "2", Title: "Sign out", Status: "done", ModifiedAt: at(8)})
+		fake.Set("frame3", "children", []interface{}{"card2"})
+		fake.Set("frame1", "children", []interface{}{"card4"})
+		fake.Set("card4", "modifiedAt", at(9).Format(time.RFC3339))
+
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{Updated: []string{"2"}, Pushed: []string{"2"}, Conflicts: []string{"2"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(fake.Get("card4", "title"), "Sign out"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff([]string{source.Get("2").Title, source.Get("2").Status}, []string{"Sign out", "todo"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		got, err = engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("issue deleted", func(t *testing.T) {
+		source.Delete("1")
+
+		got, err := engine.Sync(ctx)
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, &Report{Unlinked: []string{"1"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+}
+
+func TestEngine_Sync_Colors(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	fake.Add(`{"id": "other", "type": "card", "title": "Other", "x": 0, "y": 1000, "height": 100}`)
+
+	source := NewMemorySource(&Issue{ID: "1", Title: "Login", Status: "todo", ModifiedAt: at(1)})
+	source.Now = func() time.Time { return at(5) }
+	engine := NewEngine(client, "board", source, NewMemoryStore(), Options{
+		Colors: map[string]string{"todo": "#ffffff", "done": "#00ff00"},
+		Origin: miro.Point{X: 0, Y: 0},
+	})
+	ctx := context.Background()
+
+	if _, err := engine.Sync(ctx); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	// Below the other card, whose bottom is at 1050.
+	if diff := cmp.Diff([]interface{}{fake.Get("card1", "x"), fake.Get("card1", "y")}, []interface{}{float64(160), float64(1117)}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	fake.Set("card1", "style", map[string]interface{}{"backgroundColor": "#00ff00"})
+	got, err := engine.Sync(ctx)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, &Report{Pushed: []string{"1"}}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(source.Get("1"), &Issue{ID: "1", Title: "Login", Status: "done", ModifiedAt: at(5)}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	source.Put(&Issue{ID: "1", Title: "Login", Status: "todo", ModifiedAt: at(6)})
+	if _, err := engine.Sync(ctx); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(fake.Get("card1", "style"), map[string]interface{}{"backgroundColor": "#ffffff"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestEngine_Sync_Colors_Statuses(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	source := NewMemorySource(
+		&Issue{ID: "1", Title: "Login", Status: "todo", ModifiedAt: at(1)},
+		&Issue{ID: "2", Title: "Logout", Status: "blocked", ModifiedAt: at(1)},
+	)
+	source.Now = func() time.Time { return at(5) }
+	engine := NewEngine(client, "board", source, NewMemoryStore(), Options{
+		Colors: map[string]string{"todo": "#ffffff", "done": "#00ff00", "closed": "#00ff00"},
+	})
+	ctx := context.Background()
+
+	// The issue of a status without color is not mirrored.
+	if _, err := engine.Sync(ctx); err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if fake.Has("card2") {
+		t.Fatalf("card not expected, got:%v", fake.Get("card2", "title"))
+	}
+
+	source.Put(&Issue{ID: "2", Title: "Logout", Status: "todo", ModifiedAt: at(2)})
+	if _, err := engine.Sync(ctx); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	// A color of several statuses reads back as the first of them.
+	fake.Set("card1", "style", map[string]interface{}{"backgroundColor": "#00FF00"})
+	if _, err := engine.Sync(ctx); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(source.Get("1").Status, "closed"); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	// The card keeps its color when the status of the issue has none.
+	source.Put(&Issue{ID: "1", Title: "Login", Status: "blocked", ModifiedAt: at(6)})
+	if _, err := engine.Sync(ctx); err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if diff := cmp.Diff(fake.Get("card1", "style"), map[string]interface{}{"backgroundColor": "#00FF00"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
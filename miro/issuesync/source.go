@@ -0,0 +1,121 @@
+package issuesync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Issue represents an issue of an issue tracker.
+type Issue struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Assignee    string     `json:"assignee"`
+	DueDate     *time.Time `json:"dueDate"`
+	Status      string     `json:"status"`
+	ModifiedAt  time.Time  `json:"modifiedAt"`
+}
+
+// IssueSource is implemented by the issue trackers to sync.
+type IssueSource interface {
+	// List lists the issues to mirror on the board.
+	List(ctx context.Context) ([]*Issue, error)
+
+	// Update updates the status of the issue and returns the issue updated, with its new modification time.
+	Update(ctx context.Context, issue *Issue) (*Issue, error)
+}
+
+// MemorySource is an IssueSource holding the issues in memory, for tests and prototypes.
+type MemorySource struct {
+	mu     sync.Mutex
+	issues map[string]*Issue
+
+	// Now returns the modification time of updates. Nil uses time.Now.
+	Now func() time.Time
+}
+
+var _ IssueSource = (*MemorySource)(nil)
+
+// NewMemorySource returns a new source holding the issues.
+func NewMemorySource(issues ...*Issue) *MemorySource {
+	s := &MemorySource{issues: map[string]*Issue{}}
+	for _, i := range issues {
+		s.Put(i)
+	}
+
+	return s
+}
+
+// Put adds or replaces the issue, as if it was changed in the issue tracker.
+func (s *MemorySource) Put(issue *Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := *issue
+	s.issues[issue.ID] = &c
+}
+
+// Delete removes the issue by ID.
+func (s *MemorySource) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.issues, id)
+}
+
+// Get returns a copy of the issue by ID, nil if missing.
+func (s *MemorySource) Get(id string) *Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.issues[id]
+	if !ok {
+		return nil
+	}
+
+	c := *i
+	return &c
+}
+
+// List lists copies of the issues by ID.
+func (s *MemorySource) List(ctx context.Context) ([]*Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	issues := make([]*Issue, 0, len(s.issues))
+	for _, i := range s.issues {
+		c := *i
+		issues = append(issues, &c)
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].ID < issues[j].ID
+	})
+
+	return issues, nil
+}
+
+// Update updates the status of the issue.
+func (s *MemorySource) Update(ctx context.Context, issue *Issue) (*Issue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.issues[issue.ID]
+	if !ok {
+		return nil, fmt.Errorf("issue not found: %s", issue.ID)
+	}
+
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+
+	i.Status = issue.Status
+	i.ModifiedAt = now()
+
+	c := *i
+	return &c, nil
+}
@@ -0,0 +1,129 @@
+package issuesync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Link represents an issue mirrored on a card, as of the last sync.
+type Link struct {
+	IssueID  string `json:"issueId"`
+	WidgetID string `json:"widgetId"`
+
+	// Status is the status of the issue and the card.
+	Status string `json:"status"`
+
+	// IssueModifiedAt is the modification time of the issue.
+	IssueModifiedAt time.Time `json:"issueModifiedAt"`
+}
+
+// Store is implemented by the stores of the links between issues and cards.
+type Store interface {
+	// Load loads the links by Issue ID, empty if none was saved.
+	Load(ctx context.Context) (map[string]*Link, error)
+
+	// Save replaces the links.
+	Save(ctx context.Context, links map[string]*Link) error
+}
+
+// MemoryStore is a Store holding the links in memory.
+type MemoryStore struct {
+	mu    sync.Mutex
+	links map[string]*Link
+}
+
+var (
+	_ Store = (*MemoryStore)(nil)
+	_ Store = (*FileStore)(nil)
+)
+
+// NewMemoryStore returns a new empty store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{links: map[string]*Link{}}
+}
+
+// Load returns copies of the links.
+func (s *MemoryStore) Load(ctx context.Context) (map[string]*Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyLinks(s.links), nil
+}
+
+// Save keeps copies of the links.
+func (s *MemoryStore) Save(ctx context.Context, links map[string]*Link) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.links = copyLinks(links)
+	return nil
+}
+
+// FileStore is a Store keeping the links in a JSON file.
+type FileStore struct {
+	Path string
+}
+
+// NewFileStore returns a new store keeping the links in the file.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{Path: path}
+}
+
+// Load reads the links from the file, empty if the file does not exist yet.
+func (s *FileStore) Load(ctx context.Context) (map[string]*Link, error) {
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]*Link{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	links := map[string]*Link{}
+	if err := json.Unmarshal(b, &links); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// Save writes the links to a temporary file renamed to the file, so that the file is never half written.
+func (s *FileStore) Save(ctx context.Context, links map[string]*Link) error {
+	b, err := json.MarshalIndent(links, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.Path)
+}
+
+func copyLinks(links map[string]*Link) map[string]*Link {
+	c := make(map[string]*Link, len(links))
+	for id, l := range links {
+		lc := *l
+		c[id] = &lc
+	}
+
+	return c
+}
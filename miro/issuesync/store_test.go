@@ -0,0 +1,48 @@
+package issuesync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	links := map[string]*Link{
+		"1": {IssueID: "1", WidgetID: "w1", Status: "todo", IssueModifiedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tcs := map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(t.TempDir(), "links.json")),
+	}
+
+	for n, store := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := store.Load(ctx)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, map[string]*Link{}); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if err := store.Save(ctx, links); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			got, err = store.Load(ctx)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, links); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package miro
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// models returns a new value of every model decoded from Miro responses.
+func models() map[string]func() interface{} {
+	return map[string]func() interface{}{
+		"AuditLog":                         func() interface{} { return &AuditLog{} },
+		"AuthorizationInfo":                func() interface{} { return &AuthorizationInfo{} },
+		"Board":                            func() interface{} { return &Board{} },
+		"BoardV2":                          func() interface{} { return &BoardV2{} },
+		"BoardMemberV2":                    func() interface{} { return &BoardMemberV2{} },
+		"Card":                             func() interface{} { return &Card{} },
+		"Comment":                          func() interface{} { return &Comment{} },
+		"CommentThread":                    func() interface{} { return &CommentThread{} },
+		"ConnectorV2":                      func() interface{} { return &ConnectorV2{} },
+		"Frame":                            func() interface{} { return &Frame{} },
+		"ItemV2":                           func() interface{} { return &ItemV2{} },
+		"ListItemsV2Response":              func() interface{} { return &ListItemsV2Response{} },
+		"BoardUserConnection":              func() interface{} { return &BoardUserConnection{} },
+		"Line":                             func() interface{} { return &Line{} },
+		"ListBoardsResponse":               func() interface{} { return &ListBoardsResponse{} },
+		"ListBoardUserConnectionsResponse": func() interface{} { return &ListBoardUserConnectionsResponse{} },
+		"MiniBoard":                        func() interface{} { return &MiniBoard{} },
+		"MiniTeamUserConnection":           func() interface{} { return &MiniTeamUserConnection{} },
+		"Organization":                     func() interface{} { return &Organization{} },
+		"OrganizationMember":               func() interface{} { return &OrganizationMember{} },
+		"Picture":                          func() interface{} { return &Picture{} },
+		"RespError":                        func() interface{} { return &RespError{} },
+		"Sticker":                          func() interface{} { return &Sticker{} },
+		"Tag":                              func() interface{} { return &Tag{} },
+		"TagV2":                            func() interface{} { return &TagV2{} },
+		"Team":                             func() interface{} { return &Team{} },
+		"TeamSettings":                     func() interface{} { return &TeamSettings{} },
+		"TeamUserConnection":               func() interface{} { return &TeamUserConnection{} },
+		"User":                             func() interface{} { return &User{} },
+	}
+}
+
+func FuzzUnmarshal(f *testing.F) {
+	for _, seed := range []string{
+		getAuditLogJSON(),
+		getAuthorizationInfoJSON("1"),
+		getBoardJSON("1"),
+		getBoardUserConnectionJSON("1"),
+		getCommentThreadJSON("1", "2"),
+		getBoardV2JSON("1"),
+		getConnectorV2JSON("1"),
+		getStickyNoteV2JSON("1"),
+		getStickerJSON("1"),
+		getLineJSON("1", "2", "3"),
+		getFrameJSON("1", 0, 0, 10, 10, "2"),
+		getOrganizationMemberJSON("1", LicenseFull),
+		getPictureJSON("1"),
+		getTeamJSON("1"),
+		getTeamUserConnectionJSON("1"),
+		getUserJSON("1"),
+		`{"createdAt": 1, "createdBy": "user", "picture": [], "scopes": {}, "data": [1]}`,
+		`{"id": null, "user": null, "details": "role"}`,
+		`[]`,
+		`null`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, newModel := range models() {
+			// Only panics fail, invalid JSON is reported as an error.
+			_ = json.Unmarshal(data, newModel())
+		}
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(1995, 6, 15, 10, 0, 0, 0, time.UTC)
+	user := &MiniUser{ID: "user", Name: "Sergey"}
+	team := &MiniTeam{ID: "team", Name: "Miro"}
+	picture := &MiniPicture{ID: "picture", ImageURL: "https://test-test.com/picture.png"}
+
+	tcs := map[string]struct {
+		in interface{}
+	}{
+		"AuditLog": {&AuditLog{
+			Limit:    10,
+			Offset:   5,
+			Size:     100,
+			NextLink: "https://test-test.com/next",
+			PrevLink: "https://test-test.com/prev",
+			Data: []Data{{
+				ID:        "log",
+				Event:     "board_opened",
+				Details:   &Detail{Role: "OWNER"},
+				CreatedAt: at,
+				CreatedBy: user,
+				Context: &Context{
+					Organization: &Organization{ID: "org", Name: "Org"},
+					Team:         team,
+					IP:           "127.0.0.1",
+				},
+			}},
+		}},
+		"AuthorizationInfo": {&AuthorizationInfo{
+			ID:        "token",
+			Scopes:    []string{"boards:read"},
+			User:      user,
+			Team:      team,
+			CreatedAt: at,
+			CreatedBy: user,
+		}},
+		"Board": {&Board{
+			ID:          "board",
+			Name:        "Board",
+			Description: "desc",
+			ImageURL:    "https://test-test.com/image.png",
+			CreatedAt:   at,
+			ModifiedAt:  at,
+			CreatedBy:   user,
+			ModifiedBy:  user,
+			Owner:       user,
+			Picture:     picture,
+			ViewLink:    "https://test-test.com",
+			SharingPolicy: &SharingPolicy{
+				Access:     AccessLevelView,
+				TeamAccess: AccessLevelEdit,
+			},
+			CurrentUserConnection: &BoardUserConnection{ID: "conn", User: user, Role: BoardRoleOwner},
+		}},
+		"BoardUserConnection": {&BoardUserConnection{
+			ID:         "conn",
+			User:       user,
+			Role:       BoardRoleEditor,
+			CreatedAt:  at,
+			ModifiedAt: at,
+			CreatedBy:  user,
+			ModifiedBy: user,
+		}},
+		"BoardV2": {&BoardV2{
+			ID:         "board",
+			Type:       "board",
+			Name:       "Board",
+			CreatedAt:  at,
+			ModifiedAt: at,
+			Owner:      user,
+			Team:       team,
+			Policy: &BoardPolicyV2{
+				SharingPolicy: &SharingPolicyV2{Access: AccessLevelView, TeamAccess: AccessLevelEdit},
+			},
+			CurrentUserMember: &BoardMemberV2{ID: "user", Name: "Sergey", Role: BoardRoleCoowner},
+			Links:             &LinksV2{Self: "https://test-test.com/v2/boards/board"},
+		}},
+		"Card": {&Card{
+			WidgetBase:  WidgetBase{ID: "card", Type: WidgetTypeCard, CreatedAt: at},
+			Title:       "task",
+			Description: "details",
+			Tags:        []*Tag{{ID: "tag", Title: "urgent", Color: "red"}},
+			Assignee:    &CardAssignee{UserID: "user"},
+			DueDate:     &at,
+		}},
+		"CommentThread": {&CommentThread{
+			ID:         "thread",
+			Position:   &Point{X: 10, Y: -5},
+			Resolved:   true,
+			ResolvedAt: &at,
+			ResolvedBy: user,
+			Comments:   []*Comment{{ID: "comment", Text: "<p>LGTM</p>", CreatedAt: at, CreatedBy: user, ModifiedAt: at}},
+			CreatedAt:  at,
+			CreatedBy:  user,
+		}},
+		"ConnectorV2": {&ConnectorV2{
+			ID:        "connector",
+			Shape:     ConnectorShapeStraight,
+			StartItem: &ConnectorEndV2{ID: "1", SnapTo: "top"},
+			EndItem:   &ConnectorEndV2{ID: "2", Position: &RelativePositionV2{X: "0%", Y: "50%"}},
+			Captions:  []*CaptionV2{{Content: "caption"}},
+			CreatedAt: at,
+		}},
+		"Frame": {&Frame{
+			WidgetBase: WidgetBase{ID: "frame", Type: WidgetTypeFrame, X: -10, Y: 20.5, Width: 100, Height: 50, CreatedAt: at, CreatedBy: user},
+			Title:      "exercise",
+			Children:   []string{"1", "2"},
+			Style:      &WidgetStyle{BackgroundColor: "#ffffff"},
+		}},
+		"ItemV2": {&ItemV2{
+			ID:        "item",
+			Type:      ItemTypeCard,
+			Data:      json.RawMessage(`{"title":"card"}`),
+			Position:  &PositionV2{X: 1.5, Y: -2},
+			Geometry:  &GeometryV2{Width: 320},
+			Parent:    &ParentV2{ID: "frame"},
+			CreatedAt: at,
+			CreatedBy: user,
+		}},
+		"Line": {&Line{
+			WidgetBase:  WidgetBase{ID: "line", Type: WidgetTypeLine, CreatedAt: at},
+			StartWidget: &LineEnd{ID: "1", SnapTo: SnapLeft},
+			EndPosition: &Point{X: 10, Y: -5},
+			Captions:    []*LineCaption{{Text: "caption", Position: 0.25}},
+			Style:       &LineStyle{LineType: LineTypeStraight, LineStartType: ArrowheadCircle, BorderColor: "#000000"},
+		}},
+		"Organization": {&Organization{ID: "org", Name: "Miro", Type: "organization", Plan: "enterprise", FullLicensesPurchased: 50}},
+		"OrganizationMember": {&OrganizationMember{
+			ID:               "member",
+			Email:            "miro@test.com",
+			Role:             OrganizationRoleInternalAdmin,
+			License:          LicenseFull,
+			Active:           true,
+			LastActivityAt:   &at,
+			LicenseChangedAt: &at,
+		}},
+		"Picture": {&Picture{ID: "picture", ImageURL: "https://test-test.com/picture.png"}},
+		"Team": {&Team{
+			ID:         "team",
+			Name:       "Miro",
+			CreatedAt:  at,
+			ModifiedAt: at,
+			CreatedBy:  user,
+			ModifiedBy: user,
+			Picture:    picture,
+		}},
+		"Sticker": {&Sticker{
+			WidgetBase: WidgetBase{ID: "sticker", Type: WidgetTypeSticker, Metadata: extra("app", `{"key":"value"}`)},
+			Text:       "hello",
+		}},
+		"TeamSettings": {&TeamSettings{
+			TeamID:                    "team",
+			OrganizationID:            "org",
+			TeamSharingPolicySettings: &TeamSharingPolicySettings{DefaultBoardAccess: AccessLevelPrivate, Extra: extra("moveBoardToAccount", `"allowed"`)},
+			TeamInvitationSettings:    &TeamInvitationSettings{InviteExternalUsers: TeamInviteExternalUsersAllowed, WhoCanInvite: TeamWhoCanInviteAdminsAndMembers},
+		}},
+		"TeamUserConnection": {&TeamUserConnection{
+			ID:         "conn",
+			User:       user,
+			Team:       team,
+			Role:       "admin",
+			Name:       "conn",
+			CreatedAt:  at,
+			ModifiedAt: at,
+			CreatedBy:  user,
+			ModifiedBy: user,
+		}},
+		"MiniTeamUserConnection": {&MiniTeamUserConnection{ID: "conn", User: user, Role: "member"}},
+		"User": {&User{
+			ID:        "user",
+			Name:      "Sergey",
+			Company:   "Miro",
+			Role:      "developer",
+			Industry:  "software",
+			Email:     "miro@test.com",
+			State:     "registered",
+			CreatedAt: at,
+			Picture:   picture,
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			b, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			got := models()[n]()
+			if err := json.Unmarshal(b, got); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.in); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
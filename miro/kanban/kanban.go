@@ -0,0 +1,435 @@
+// Package kanban drives Kanban boards on Miro, with columns of cards.
+//
+// Columns are frames, or shapes with Options.Shapes, placed side by side. The cards of a
+// column are stacked from its top in the order of the column, and the columns are
+// reflowed when cards are added or moved so that the cards never overlap.
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const (
+	defaultColumnWidth  = 360
+	defaultColumnHeight = 1200
+	defaultColumnGap    = 40
+	defaultCardGap      = 20
+	defaultPadding      = 20
+	defaultCardHeight   = 94
+
+	// shapeHeader is the space for the title at the top of shape columns,
+	// as frames have their title above them.
+	shapeHeader = 60
+)
+
+// Options specifies the parameters of a Kanban board. Zero values use the defaults.
+type Options struct {
+	// Shapes makes the columns shapes instead of frames.
+	// The cards of shape columns are the cards with their center in the shape.
+	Shapes bool
+
+	// ColumnWidth and ColumnHeight are the size of new columns.
+	// Columns grow when their cards need more height.
+	ColumnWidth  float64
+	ColumnHeight float64
+
+	// ColumnGap is the space between the columns.
+	ColumnGap float64
+
+	// CardGap is the space between the cards of a column.
+	CardGap float64
+
+	// Padding is the space between the sides of a column and its cards.
+	Padding float64
+
+	// Origin is the position of the top left corner of the first column.
+	Origin miro.Point
+}
+
+// Column represents a column of the Kanban board.
+type Column struct {
+	ID    string
+	Title string
+
+	// Widget is the frame or the shape of the column.
+	Widget miro.Widget
+
+	// Cards are the cards of the column from top to bottom.
+	Cards []*miro.Card
+}
+
+// Board represents a Kanban board on a Miro board.
+type Board struct {
+	client  *miro.Client
+	boardID string
+	opt     Options
+	columns []*Column
+	loose   []*miro.Card
+}
+
+// Open loads the columns and the cards of the Kanban board on the board by Board ID.
+func Open(ctx context.Context, client *miro.Client, boardID string, opt Options) (*Board, error) {
+	if opt.ColumnWidth <= 0 {
+		opt.ColumnWidth = defaultColumnWidth
+	}
+
+	if opt.ColumnHeight <= 0 {
+		opt.ColumnHeight = defaultColumnHeight
+	}
+
+	if opt.ColumnGap <= 0 {
+		opt.ColumnGap = defaultColumnGap
+	}
+
+	if opt.CardGap <= 0 {
+		opt.CardGap = defaultCardGap
+	}
+
+	if opt.Padding <= 0 {
+		opt.Padding = defaultPadding
+	}
+
+	b := &Board{client: client, boardID: boardID, opt: opt}
+	if err := b.Load(ctx); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Load loads the columns and the cards again, to see the changes made on the board by others.
+// Columns are ordered from left to right, and cards out of every column are ignored.
+func (b *Board) Load(ctx context.Context) error {
+	widgets, _, err := b.client.Widgets.List(ctx, b.boardID, "")
+	if err != nil {
+		return err
+	}
+
+	columns := []*Column{}
+	for _, w := range widgets {
+		switch w := w.(type) {
+		case *miro.Frame:
+			if !b.opt.Shapes {
+				columns = append(columns, &Column{ID: w.ID, Title: w.Title, Widget: w, Cards: []*miro.Card{}})
+			}
+		case *miro.Shape:
+			if b.opt.Shapes {
+				columns = append(columns, &Column{ID: w.ID, Title: w.Text, Widget: w, Cards: []*miro.Card{}})
+			}
+		}
+	}
+
+	sort.SliceStable(columns, func(i, j int) bool {
+		return columns[i].Widget.Base().X < columns[j].Widget.Base().X
+	})
+
+	loose := []*miro.Card{}
+	for _, w := range widgets {
+		card, ok := w.(*miro.Card)
+		if !ok {
+			continue
+		}
+
+		held := false
+		for _, c := range columns {
+			if b.holds(c, card) {
+				c.Cards = append(c.Cards, card)
+				held = true
+				break
+			}
+		}
+
+		if !held {
+			loose = append(loose, card)
+		}
+	}
+
+	for _, c := range columns {
+		sort.SliceStable(c.Cards, func(i, j int) bool {
+			return c.Cards[i].Y < c.Cards[j].Y
+		})
+	}
+
+	b.columns = columns
+	b.loose = loose
+	return nil
+}
+
+// holds reports whether the card belongs to the column.
+func (b *Board) holds(c *Column, card *miro.Card) bool {
+	if f, ok := c.Widget.(*miro.Frame); ok {
+		return f.HasChild(card.ID)
+	}
+
+	cb := c.Widget.Base()
+	return card.X >= cb.X-cb.Width/2 && card.X <= cb.X+cb.Width/2 &&
+		card.Y >= cb.Y-cb.Height/2 && card.Y <= cb.Y+cb.Height/2
+}
+
+// Columns returns the columns from left to right.
+func (b *Board) Columns() []*Column {
+	return b.columns
+}
+
+// Loose returns the cards on the board out of every column.
+func (b *Board) Loose() []*miro.Card {
+	return b.loose
+}
+
+// Column returns the column by ID, nil if the board has no such column.
+func (b *Board) Column(columnID string) *Column {
+	for _, c := range b.columns {
+		if c.ID == columnID {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// ListColumn loads the board again and returns the cards of the column from top to bottom.
+func (b *Board) ListColumn(ctx context.Context, columnID string) ([]*miro.Card, error) {
+	if err := b.Load(ctx); err != nil {
+		return nil, err
+	}
+
+	c := b.Column(columnID)
+	if c == nil {
+		return nil, fmt.Errorf("column not found: %s", columnID)
+	}
+
+	return c.Cards, nil
+}
+
+// AddColumn creates a column with the title on the right of the last column.
+func (b *Board) AddColumn(ctx context.Context, title string) (*Column, error) {
+	x := b.opt.Origin.X
+	top := b.opt.Origin.Y
+	if n := len(b.columns); n > 0 {
+		last := b.columns[n-1].Widget.Base()
+		x = last.X + last.Width/2 + b.opt.ColumnGap
+		top = last.Y - last.Height/2
+	}
+
+	base := miro.WidgetBase{
+		X:      x + b.opt.ColumnWidth/2,
+		Y:      top + b.opt.ColumnHeight/2,
+		Width:  b.opt.ColumnWidth,
+		Height: b.opt.ColumnHeight,
+	}
+
+	var w miro.Widget = &miro.Frame{WidgetBase: base, Title: title}
+	if b.opt.Shapes {
+		w = &miro.Shape{
+			WidgetBase: base,
+			Text:       title,
+			Style:      &miro.WidgetStyle{ShapeType: "rectangle", TextAlignVertical: "top"},
+		}
+	}
+
+	created, _, err := b.client.Widgets.Create(ctx, b.boardID, w)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Column{ID: created.Base().ID, Title: title, Widget: created, Cards: []*miro.Card{}}
+	b.columns = append(b.columns, c)
+	return c, nil
+}
+
+// AddCard creates a copy of the card at the bottom of the column, reflows the column and returns the card created.
+func (b *Board) AddCard(ctx context.Context, columnID string, card *miro.Card) (*miro.Card, error) {
+	c := b.Column(columnID)
+	if c == nil {
+		return nil, fmt.Errorf("column not found: %s", columnID)
+	}
+
+	nc := *card
+	nc.ID = ""
+	nc.Type = miro.WidgetTypeCard
+	nc.X, nc.Y = b.slot(c, len(c.Cards), &nc)
+
+	w, _, err := b.client.Widgets.Create(ctx, b.boardID, &nc)
+	if err != nil {
+		return nil, err
+	}
+
+	created, ok := w.(*miro.Card)
+	if !ok {
+		return nil, fmt.Errorf("widget %s is not a card, got:%s", w.Base().ID, w.Base().Type)
+	}
+
+	if _, ok := c.Widget.(*miro.Frame); ok {
+		f, _, err := b.client.Widgets.MoveIntoFrame(ctx, b.boardID, c.ID, created.ID)
+		if err != nil {
+			return nil, err
+		}
+		c.Widget = f
+	}
+
+	c.Cards = append(c.Cards, created)
+	return created, b.Reflow(ctx, c.ID)
+}
+
+// MoveCard moves the card to the position in the column, 0 being the top.
+// A negative or too large position moves the card to the bottom.
+// The cards of the columns the card leaves and joins are reflowed.
+func (b *Board) MoveCard(ctx context.Context, cardID, columnID string, position int) error {
+	to := b.Column(columnID)
+	if to == nil {
+		return fmt.Errorf("column not found: %s", columnID)
+	}
+
+	var from *Column
+	var card *miro.Card
+	for _, c := range b.columns {
+		for i, cd := range c.Cards {
+			if cd.ID == cardID {
+				from, card = c, cd
+				c.Cards = append(c.Cards[:i:i], c.Cards[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if card == nil {
+		return fmt.Errorf("card not found in any column: %s", cardID)
+	}
+
+	if position < 0 || position > len(to.Cards) {
+		position = len(to.Cards)
+	}
+	to.Cards = append(to.Cards[:position:position], append([]*miro.Card{card}, to.Cards[position:]...)...)
+
+	if from != to {
+		if _, ok := from.Widget.(*miro.Frame); ok {
+			f, _, err := b.client.Widgets.MoveOutOfFrame(ctx, b.boardID, from.ID, card.ID)
+			if err != nil {
+				return err
+			}
+			from.Widget = f
+		}
+
+		if _, ok := to.Widget.(*miro.Frame); ok {
+			f, _, err := b.client.Widgets.MoveIntoFrame(ctx, b.boardID, to.ID, card.ID)
+			if err != nil {
+				return err
+			}
+			to.Widget = f
+		}
+
+		if err := b.Reflow(ctx, from.ID); err != nil {
+			return err
+		}
+	}
+
+	return b.Reflow(ctx, to.ID)
+}
+
+// Reflow stacks the cards of the column from its top in their order, moving only the cards out of place.
+func (b *Board) Reflow(ctx context.Context, columnID string) error {
+	c := b.Column(columnID)
+	if c == nil {
+		return fmt.Errorf("column not found: %s", columnID)
+	}
+
+	positions := map[string]miro.Point{}
+	for i, card := range c.Cards {
+		x, y := b.slot(c, i, card)
+		if card.X != x || card.Y != y {
+			positions[card.ID] = miro.Point{X: x, Y: y}
+		}
+	}
+
+	if len(positions) > 0 {
+		// The cards are only moved in memory once moved on the board, MoveMany stopping at the first error.
+		moved, _, err := b.client.Widgets.MoveMany(ctx, b.boardID, positions)
+		for _, w := range moved {
+			for _, card := range c.Cards {
+				if card.ID == w.Base().ID {
+					p := positions[card.ID]
+					card.X, card.Y = p.X, p.Y
+				}
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.fit(ctx, c)
+}
+
+// slot returns the position of the center of the card at the index of the column, below the cards before it.
+func (b *Board) slot(c *Column, index int, card *miro.Card) (float64, float64) {
+	cb := c.Widget.Base()
+	y := b.top(c)
+	for _, before := range c.Cards[:index] {
+		y += cardHeight(before) + b.opt.CardGap
+	}
+
+	return cb.X, y + cardHeight(card)/2
+}
+
+// top returns the top of the first card of the column.
+func (b *Board) top(c *Column) float64 {
+	cb := c.Widget.Base()
+	top := cb.Y - cb.Height/2 + b.opt.Padding
+	if _, ok := c.Widget.(*miro.Shape); ok {
+		top += shapeHeader
+	}
+
+	return top
+}
+
+// fit makes the column taller when its cards need more height, keeping its top in place.
+func (b *Board) fit(ctx context.Context, c *Column) error {
+	cb := c.Widget.Base()
+	bottom := b.top(c)
+	for _, card := range c.Cards {
+		bottom += cardHeight(card) + b.opt.CardGap
+	}
+	bottom += b.opt.Padding - b.opt.CardGap
+
+	top := cb.Y - cb.Height/2
+	if bottom <= top+cb.Height {
+		return nil
+	}
+
+	w, _, err := b.client.Widgets.Update(ctx, b.boardID, c.ID, resized(c.Widget, top+(bottom-top)/2, bottom-top))
+	if err != nil {
+		return err
+	}
+
+	c.Widget = w
+	return nil
+}
+
+// resized returns a copy of the column widget with the center and height, leaving the widget unchanged.
+func resized(w miro.Widget, y, height float64) miro.Widget {
+	switch w := w.(type) {
+	case *miro.Frame:
+		f := *w
+		f.Y, f.Height = y, height
+		return &f
+	case *miro.Shape:
+		s := *w
+		s.Y, s.Height = y, height
+		return &s
+	}
+
+	return w
+}
+
+func cardHeight(c *miro.Card) float64 {
+	if c.Height > 0 {
+		return c.Height
+	}
+
+	return defaultCardHeight
+}
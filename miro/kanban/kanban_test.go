@@ -0,0 +1,212 @@
+package kanban
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// positions returns the titles and the positions of the cards of the column.
+func positions(c *Column) []string {
+	got := []string{}
+	for _, card := range c.Cards {
+		got = append(got, fmt.Sprintf("%s %g,%g", card.Title, card.X, card.Y))
+	}
+
+	return got
+}
+
+func TestBoard(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	fake.Add(`{"id": "done", "type": "frame", "title": "Done", "x": 600, "y": 500, "width": 400, "height": 1000, "children": []}`)
+	fake.Add(`{"id": "todo", "type": "frame", "title": "To do", "x": 200, "y": 500, "width": 400, "height": 1000, "children": ["b", "a"]}`)
+	fake.Add(`{"id": "a", "type": "card", "title": "A", "x": 200, "y": 70, "height": 100}`)
+	fake.Add(`{"id": "b", "type": "card", "title": "B", "x": 200, "y": 300, "height": 100}`)
+	fake.Add(`{"id": "loose", "type": "card", "title": "Loose", "x": 5000, "y": 5000}`)
+
+	ctx := context.Background()
+	b, err := Open(ctx, client, "board", Options{})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	titles := []string{}
+	for _, c := range b.Columns() {
+		titles = append(titles, c.Title)
+	}
+
+	if diff := cmp.Diff(titles, []string{"To do", "Done"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(positions(b.Column("todo")), []string{"A 200,70", "B 200,300"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(len(b.Loose()), 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	t.Run("AddCard", func(t *testing.T) {
+		card, err := b.AddCard(ctx, "todo", &miro.Card{Title: "C"})
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(card.Title, "C"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		// The cards are stacked from the top of the column at 0, C has the default height.
+		if diff := cmp.Diff(positions(b.Column("todo")), []string{"A 200,70", "B 200,190", "C 200,307"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if !b.Column("todo").Widget.(*miro.Frame).HasChild(card.ID) {
+			t.Fatalf("card not in frame, got:%v", b.Column("todo").Widget.(*miro.Frame).Children)
+		}
+	})
+
+	t.Run("MoveCard", func(t *testing.T) {
+		if err := b.MoveCard(ctx, "a", "done", 0); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if err := b.MoveCard(ctx, "card1", "todo", 0); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(positions(b.Column("todo")), []string{"C 200,67", "B 200,184"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if diff := cmp.Diff(positions(b.Column("done")), []string{"A 600,70"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+
+	t.Run("ListColumn", func(t *testing.T) {
+		got, err := b.ListColumn(ctx, "todo")
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		ids := []string{}
+		for _, c := range got {
+			ids = append(ids, c.ID)
+		}
+
+		if diff := cmp.Diff(ids, []string{"card1", "b"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		if _, err := b.ListColumn(ctx, "missing"); err == nil {
+			t.Fatalf("Should failed")
+		}
+	})
+
+	t.Run("AddColumn", func(t *testing.T) {
+		c, err := b.AddColumn(ctx, "Archive")
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		got := c.Widget.Base()
+		if diff := cmp.Diff([]float64{got.X, got.Y, got.Width, got.Height}, []float64{1020, 600, 360, 1200}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+}
+
+func TestBoard_Shapes(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	fake.Add(`{"id": "col", "type": "shape", "text": "Doing", "x": 100, "y": 150, "width": 200, "height": 300}`)
+	fake.Add(`{"id": "a", "type": "card", "title": "A", "x": 100, "y": 100}`)
+
+	ctx := context.Background()
+	b, err := Open(ctx, client, "board", Options{Shapes: true, CardGap: 10, Padding: 10})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if _, err := b.AddCard(ctx, "col", &miro.Card{Title: "B"}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if _, err := b.AddCard(ctx, "col", &miro.Card{Title: "C"}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if err := b.Reflow(ctx, "col"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	// The header and the cards need 10 + 60 + 3*94 + 2*10 + 10 = 382 and the column grows from 300.
+	if diff := cmp.Diff(positions(b.Column("col")), []string{"A 100,117", "B 100,221", "C 100,325"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	got := b.Column("col").Widget.Base()
+	if diff := cmp.Diff([]float64{got.Y, got.Height}, []float64{191, 382}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestBoard_Reflow_Failed(t *testing.T) {
+	client, fake, teardown := mirotest.SetupBoard("board")
+	defer teardown()
+
+	fake.Add(`{"id": "col", "type": "shape", "text": "Doing", "x": 100, "y": 150, "width": 200, "height": 300}`)
+	fake.Add(`{"id": "a", "type": "card", "title": "A", "x": 100, "y": 100}`)
+	fake.Add(`{"id": "b", "type": "card", "title": "B", "x": 100, "y": 200}`)
+	fake.Add(`{"id": "c", "type": "card", "title": "C", "x": 100, "y": 280}`)
+
+	ctx := context.Background()
+	b, err := Open(ctx, client, "board", Options{Shapes: true, CardGap: 10, Padding: 10})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	// Only the cards moved before the failing one are moved in memory.
+	fake.Fail("b")
+	if err := b.Reflow(ctx, "col"); err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if diff := cmp.Diff(positions(b.Column("col")), []string{"A 100,117", "B 100,200", "C 100,280"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	// The column keeps its geometry when growing it fails.
+	fake.Fail("col")
+	if err := b.Reflow(ctx, "col"); err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if diff := cmp.Diff(positions(b.Column("col")), []string{"A 100,117", "B 100,221", "C 100,325"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	got := b.Column("col").Widget.Base()
+	if diff := cmp.Diff([]float64{got.Y, got.Height}, []float64{150, 300}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	fake.Fail()
+	if err := b.Reflow(ctx, "col"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got = b.Column("col").Widget.Base()
+	if diff := cmp.Diff([]float64{got.Y, got.Height}, []float64{191, 382}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
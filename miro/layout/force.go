@@ -0,0 +1,137 @@
+package layout
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ForceOptions specifies the parameters of ForceDirected.
+type ForceOptions struct {
+	// Iterations is the number of steps of the simulation. Zero uses 100.
+	Iterations int
+
+	// Seed is the seed of the initial positions, the same seed gives the same positions.
+	Seed int64
+
+	// Width and Height are the size of the area to place the nodes in.
+	Width  float64
+	Height float64
+
+	// Origin is the position of the top left corner of the area.
+	Origin Point
+}
+
+// ForceDirected places the nodes with the Fruchterman-Reingold algorithm, where the
+// edges pull the nodes together and every node pushes the others away.
+// Edges are not directed, and the layout is deterministic for the same seed.
+func ForceDirected(nodes []Node, edges []Edge, opt ForceOptions) Positions {
+	pos := Positions{}
+	if len(nodes) == 0 {
+		return pos
+	}
+
+	iterations := opt.Iterations
+	if iterations <= 0 {
+		iterations = 100
+	}
+
+	width, height := opt.Width, opt.Height
+	if width <= 0 || height <= 0 {
+		cell := maxSize(nodes)
+		side := math.Sqrt(float64(len(nodes))) * 2 * math.Max(cell.Width, cell.Height)
+		width, height = math.Max(side, 1), math.Max(side, 1)
+	}
+
+	index := make(map[string]int, len(nodes))
+	for i, n := range nodes {
+		index[n.ID] = i
+	}
+
+	type link struct{ from, to int }
+	links := []link{}
+	for _, e := range edges {
+		from, ok := index[e.From]
+		if !ok {
+			continue
+		}
+
+		to, ok := index[e.To]
+		if !ok || from == to {
+			continue
+		}
+
+		links = append(links, link{from, to})
+	}
+
+	r := rand.New(rand.NewSource(opt.Seed))
+	xs := make([]float64, len(nodes))
+	ys := make([]float64, len(nodes))
+	for i := range nodes {
+		xs[i] = r.Float64() * width
+		ys[i] = r.Float64() * height
+	}
+
+	k := math.Sqrt(width * height / float64(len(nodes)))
+	temp := math.Max(width, height) / 10
+	cool := temp / float64(iterations+1)
+
+	dx := make([]float64, len(nodes))
+	dy := make([]float64, len(nodes))
+	for it := 0; it < iterations; it++ {
+		for i := range nodes {
+			dx[i], dy[i] = 0, 0
+		}
+
+		for i := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				vx, vy, d := delta(xs[i], ys[i], xs[j], ys[j])
+				f := k * k / d
+				dx[i] += vx / d * f
+				dy[i] += vy / d * f
+				dx[j] -= vx / d * f
+				dy[j] -= vy / d * f
+			}
+		}
+
+		for _, l := range links {
+			vx, vy, d := delta(xs[l.from], ys[l.from], xs[l.to], ys[l.to])
+			f := d * d / k
+			dx[l.from] -= vx / d * f
+			dy[l.from] -= vy / d * f
+			dx[l.to] += vx / d * f
+			dy[l.to] += vy / d * f
+		}
+
+		for i := range nodes {
+			d := math.Hypot(dx[i], dy[i])
+			if d > 0 {
+				step := math.Min(d, temp)
+				xs[i] += dx[i] / d * step
+				ys[i] += dy[i] / d * step
+			}
+
+			xs[i] = math.Min(width, math.Max(0, xs[i]))
+			ys[i] = math.Min(height, math.Max(0, ys[i]))
+		}
+
+		temp -= cool
+	}
+
+	for i, n := range nodes {
+		pos[n.ID] = Point{X: opt.Origin.X + xs[i], Y: opt.Origin.Y + ys[i]}
+	}
+
+	return pos
+}
+
+// delta returns the vector from b to a and its length, never zero so that
+// overlapping nodes are pushed apart.
+func delta(ax, ay, bx, by float64) (float64, float64, float64) {
+	vx, vy := ax-bx, ay-by
+	d := math.Hypot(vx, vy)
+	if d < 0.01 {
+		return 0.01, 0, 0.01
+	}
+
+	return vx, vy, d
+}
@@ -0,0 +1,37 @@
+package layout
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestForceDirected(t *testing.T) {
+	nodes := square("1", "2", "3", "4", "5", "6")
+	edges := []Edge{{"1", "2"}, {"2", "3"}, {"3", "1"}, {"4", "5"}, {"5", "6"}}
+	opt := ForceOptions{Seed: 42, Width: 1000, Height: 1000, Origin: Point{X: 100, Y: 100}}
+
+	got := ForceDirected(nodes, edges, opt)
+	if len(got) != len(nodes) {
+		t.Fatalf("positions not expected, got:%v", got)
+	}
+
+	for id, p := range got {
+		if p.X < 100 || p.X > 1100 || p.Y < 100 || p.Y > 1100 {
+			t.Fatalf("position of %s out of the area, got:%v", id, p)
+		}
+	}
+
+	if diff := cmp.Diff(ForceDirected(nodes, edges, opt), got); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	dist := func(a, b string) float64 {
+		return math.Hypot(got[a].X-got[b].X, got[a].Y-got[b].Y)
+	}
+
+	if dist("1", "2") >= dist("1", "5") || dist("4", "5") >= dist("3", "6") {
+		t.Fatalf("connected nodes should be closer, got:%v", got)
+	}
+}
@@ -0,0 +1,156 @@
+// Package layout computes the positions of widgets on a board.
+//
+// Layouts are pure functions of the node sizes and edges and do not call Miro.
+// They return the position of the center of each node by Node ID, as Miro
+// positions widgets by their center, and the positions can be converted to
+// miro.Point and applied with WidgetsService.MoveMany.
+package layout
+
+import (
+	"math"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Point represents a position on a board. It converts to miro.Point.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// ToMiro returns the point as miro.Point.
+func (p Point) ToMiro() miro.Point {
+	return miro.Point{X: p.X, Y: p.Y}
+}
+
+// Size represents the size of a node.
+type Size struct {
+	Width  float64
+	Height float64
+}
+
+// Node represents a widget to place. IDs must be unique.
+type Node struct {
+	ID   string
+	Size Size
+
+	// Group is the column of the node in Columns.
+	Group string
+}
+
+// Edge represents a relation from a node to another one, such as a parent to its child in Tree.
+type Edge struct {
+	From string
+	To   string
+}
+
+// Positions represents the positions of the center of the nodes by Node ID.
+type Positions map[string]Point
+
+// ToMiro returns the positions as miro.Point by Node ID, to be applied with WidgetsService.MoveMany
+// when the Node IDs are Widget IDs.
+func (p Positions) ToMiro() map[string]miro.Point {
+	m := make(map[string]miro.Point, len(p))
+	for id, point := range p {
+		m[id] = point.ToMiro()
+	}
+
+	return m
+}
+
+// GridOptions specifies the parameters of Grid.
+type GridOptions struct {
+	// Columns is the number of columns. Zero makes the grid as square as possible.
+	Columns int
+
+	// Gap is the space between the cells.
+	Gap float64
+
+	// Origin is the position of the top left corner of the grid.
+	Origin Point
+}
+
+// Grid places the nodes in the cells of a grid, row by row in the order of nodes.
+// Every cell has the size of the largest node.
+func Grid(nodes []Node, opt GridOptions) Positions {
+	pos := Positions{}
+	if len(nodes) == 0 {
+		return pos
+	}
+
+	cols := opt.Columns
+	if cols <= 0 {
+		cols = int(math.Ceil(math.Sqrt(float64(len(nodes)))))
+	}
+
+	cell := maxSize(nodes)
+	for i, n := range nodes {
+		col, row := i%cols, i/cols
+		pos[n.ID] = Point{
+			X: opt.Origin.X + float64(col)*(cell.Width+opt.Gap) + cell.Width/2,
+			Y: opt.Origin.Y + float64(row)*(cell.Height+opt.Gap) + cell.Height/2,
+		}
+	}
+
+	return pos
+}
+
+// ColumnsOptions specifies the parameters of Columns.
+type ColumnsOptions struct {
+	// Groups is the order of the columns. Groups of nodes missing from it are added after, in the order of nodes.
+	Groups []string
+
+	// Gap is the space between the nodes in a column.
+	Gap float64
+
+	// ColumnGap is the space between the columns.
+	ColumnGap float64
+
+	// Origin is the position of the top left corner of the first column.
+	Origin Point
+}
+
+// Columns stacks the nodes in a column per group, like the lanes of a Kanban board.
+// Every column has the width of the widest node.
+func Columns(nodes []Node, opt ColumnsOptions) Positions {
+	pos := Positions{}
+	if len(nodes) == 0 {
+		return pos
+	}
+
+	index := map[string]int{}
+	for _, g := range opt.Groups {
+		if _, ok := index[g]; !ok {
+			index[g] = len(index)
+		}
+	}
+
+	for _, n := range nodes {
+		if _, ok := index[n.Group]; !ok {
+			index[n.Group] = len(index)
+		}
+	}
+
+	width := maxSize(nodes).Width
+	next := make([]float64, len(index))
+	for _, n := range nodes {
+		col := index[n.Group]
+		pos[n.ID] = Point{
+			X: opt.Origin.X + float64(col)*(width+opt.ColumnGap) + width/2,
+			Y: opt.Origin.Y + next[col] + n.Size.Height/2,
+		}
+		next[col] += n.Size.Height + opt.Gap
+	}
+
+	return pos
+}
+
+func maxSize(nodes []Node) Size {
+	s := Size{}
+	for _, n := range nodes {
+		s.Width = math.Max(s.Width, n.Size.Width)
+		s.Height = math.Max(s.Height, n.Size.Height)
+	}
+
+	return s
+}
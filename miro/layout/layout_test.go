@@ -0,0 +1,92 @@
+package layout
+
+import (
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPositions_ToMiro(t *testing.T) {
+	tcs := map[string]struct {
+		in   Positions
+		want map[string]miro.Point
+	}{
+		"points": {Positions{"1": {X: 10, Y: -20}, "2": {X: 0.5, Y: 0}}, map[string]miro.Point{"1": {X: 10, Y: -20}, "2": {X: 0.5, Y: 0}}},
+		"empty":  {Positions{}, map[string]miro.Point{}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(tc.in.ToMiro(), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestGrid(t *testing.T) {
+	nodes := []Node{
+		{ID: "1", Size: Size{Width: 100, Height: 50}},
+		{ID: "2", Size: Size{Width: 80, Height: 60}},
+		{ID: "3", Size: Size{Width: 10, Height: 10}},
+		{ID: "4", Size: Size{Width: 10, Height: 10}},
+		{ID: "5", Size: Size{Width: 10, Height: 10}},
+	}
+
+	tcs := map[string]struct {
+		nodes []Node
+		opt   GridOptions
+		want  Positions
+	}{
+		"square": {nodes, GridOptions{Gap: 10}, Positions{
+			"1": {X: 50, Y: 30}, "2": {X: 160, Y: 30}, "3": {X: 270, Y: 30},
+			"4": {X: 50, Y: 100}, "5": {X: 160, Y: 100},
+		}},
+		"columns and origin": {nodes[:3], GridOptions{Columns: 2, Origin: Point{X: -100, Y: 100}}, Positions{
+			"1": {X: -50, Y: 130}, "2": {X: 50, Y: 130}, "3": {X: -50, Y: 190},
+		}},
+		"empty": {nil, GridOptions{}, Positions{}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(Grid(tc.nodes, tc.opt), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	nodes := []Node{
+		{ID: "1", Size: Size{Width: 100, Height: 50}, Group: "doing"},
+		{ID: "2", Size: Size{Width: 80, Height: 100}, Group: "todo"},
+		{ID: "3", Size: Size{Width: 100, Height: 50}, Group: "todo"},
+		{ID: "4", Size: Size{Width: 100, Height: 50}, Group: "done"},
+	}
+
+	tcs := map[string]struct {
+		opt  ColumnsOptions
+		want Positions
+	}{
+		"groups": {ColumnsOptions{Groups: []string{"todo", "doing"}, Gap: 10, ColumnGap: 20}, Positions{
+			"2": {X: 50, Y: 50}, "3": {X: 50, Y: 135},
+			"1": {X: 170, Y: 25},
+			"4": {X: 290, Y: 25},
+		}},
+		"order of nodes": {ColumnsOptions{}, Positions{
+			"1": {X: 50, Y: 25},
+			"2": {X: 150, Y: 50}, "3": {X: 150, Y: 125},
+			"4": {X: 250, Y: 25},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(Columns(nodes, tc.opt), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package layout
+
+import (
+	"math"
+)
+
+// Direction represents the direction a tree grows in.
+type Direction int
+
+const (
+	// TopDown places the roots at the top and their children below.
+	TopDown Direction = iota
+	// LeftRight places the roots on the left and their children on the right.
+	LeftRight
+)
+
+// TreeOptions specifies the parameters of Tree.
+type TreeOptions struct {
+	Direction Direction
+
+	// LevelGap is the space between a node and its children.
+	LevelGap float64
+
+	// SiblingGap is the space between nodes on the same level.
+	SiblingGap float64
+
+	// Origin is the position of the top left corner of the tree.
+	Origin Point
+}
+
+// forest represents the spanning forest of the nodes along the edges.
+type forest struct {
+	nodes    map[string]Node
+	roots    []string
+	children map[string][]string
+}
+
+// newForest builds the spanning forest of the nodes, rooted at root when it is not empty.
+// The roots are the nodes without parent in the order of nodes, and nodes only reachable
+// through a cycle become roots so that every node is in the forest exactly once.
+func newForest(nodes []Node, edges []Edge, root string) *forest {
+	f := &forest{
+		nodes:    make(map[string]Node, len(nodes)),
+		children: map[string][]string{},
+	}
+
+	for _, n := range nodes {
+		f.nodes[n.ID] = n
+	}
+
+	adj := map[string][]string{}
+	hasParent := map[string]bool{}
+	for _, e := range edges {
+		if _, ok := f.nodes[e.From]; !ok {
+			continue
+		}
+
+		if _, ok := f.nodes[e.To]; !ok || e.From == e.To {
+			continue
+		}
+
+		adj[e.From] = append(adj[e.From], e.To)
+		hasParent[e.To] = true
+	}
+
+	visited := map[string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		visited[id] = true
+		for _, c := range adj[id] {
+			if !visited[c] {
+				f.children[id] = append(f.children[id], c)
+				visit(c)
+			}
+		}
+	}
+
+	if _, ok := f.nodes[root]; ok {
+		f.roots = append(f.roots, root)
+		visit(root)
+	}
+
+	for _, n := range nodes {
+		if !visited[n.ID] && !hasParent[n.ID] {
+			f.roots = append(f.roots, n.ID)
+			visit(n.ID)
+		}
+	}
+
+	for _, n := range nodes {
+		if !visited[n.ID] {
+			f.roots = append(f.roots, n.ID)
+			visit(n.ID)
+		}
+	}
+
+	return f
+}
+
+// Tree places the nodes as a tree along the edges from parents to children,
+// centering every parent over its children. Nodes without parent are the roots,
+// placed side by side in the order of nodes.
+func Tree(nodes []Node, edges []Edge, opt TreeOptions) Positions {
+	pos := Positions{}
+	if len(nodes) == 0 {
+		return pos
+	}
+
+	f := newForest(nodes, edges, "")
+
+	breadth := func(id string) float64 {
+		if opt.Direction == LeftRight {
+			return f.nodes[id].Size.Height
+		}
+		return f.nodes[id].Size.Width
+	}
+
+	depth := func(id string) float64 {
+		if opt.Direction == LeftRight {
+			return f.nodes[id].Size.Width
+		}
+		return f.nodes[id].Size.Height
+	}
+
+	// The extent of every level is the deepest node on it.
+	levels := []float64{}
+	level := map[string]int{}
+	var walk func(id string, l int)
+	walk = func(id string, l int) {
+		level[id] = l
+		if l == len(levels) {
+			levels = append(levels, 0)
+		}
+		levels[l] = math.Max(levels[l], depth(id))
+
+		for _, c := range f.children[id] {
+			walk(c, l+1)
+		}
+	}
+
+	for _, r := range f.roots {
+		walk(r, 0)
+	}
+
+	offsets := make([]float64, len(levels))
+	for l := 1; l < len(levels); l++ {
+		offsets[l] = offsets[l-1] + levels[l-1] + opt.LevelGap
+	}
+
+	subtree := map[string]float64{}
+	var measure func(id string) float64
+	measure = func(id string) float64 {
+		sum := 0.0
+		for i, c := range f.children[id] {
+			if i > 0 {
+				sum += opt.SiblingGap
+			}
+			sum += measure(c)
+		}
+
+		subtree[id] = math.Max(breadth(id), sum)
+		return subtree[id]
+	}
+
+	var place func(id string, start float64)
+	place = func(id string, start float64) {
+		b := start + subtree[id]/2
+		d := offsets[level[id]] + levels[level[id]]/2
+
+		if opt.Direction == LeftRight {
+			pos[id] = Point{X: opt.Origin.X + d, Y: opt.Origin.Y + b}
+		} else {
+			pos[id] = Point{X: opt.Origin.X + b, Y: opt.Origin.Y + d}
+		}
+
+		sum := 0.0
+		for i, c := range f.children[id] {
+			if i > 0 {
+				sum += opt.SiblingGap
+			}
+			sum += subtree[c]
+		}
+
+		next := start + (subtree[id]-sum)/2
+		for _, c := range f.children[id] {
+			place(c, next)
+			next += subtree[c] + opt.SiblingGap
+		}
+	}
+
+	start := 0.0
+	for _, r := range f.roots {
+		measure(r)
+		place(r, start)
+		start += subtree[r] + opt.SiblingGap
+	}
+
+	return pos
+}
+
+// RadialOptions specifies the parameters of Radial.
+type RadialOptions struct {
+	// Root is the Node ID at the center. Empty places every root on the first ring.
+	Root string
+
+	// RadiusStep is the distance between the rings. Zero uses twice the largest side of the nodes,
+	// leaving a node size between the nodes of consecutive rings.
+	RadiusStep float64
+
+	// Origin is the center of the layout.
+	Origin Point
+}
+
+// Radial places the nodes as a mind map, with the root at the center and the
+// descendants on rings around it. Every node gets an angle proportional to its number of leaves.
+func Radial(nodes []Node, edges []Edge, opt RadialOptions) Positions {
+	pos := Positions{}
+	if len(nodes) == 0 {
+		return pos
+	}
+
+	step := opt.RadiusStep
+	if step <= 0 {
+		cell := maxSize(nodes)
+		step = math.Max(2*math.Max(cell.Width, cell.Height), 1)
+	}
+
+	f := newForest(nodes, edges, opt.Root)
+
+	leaves := map[string]int{}
+	var count func(id string) int
+	count = func(id string) int {
+		n := 0
+		for _, c := range f.children[id] {
+			n += count(c)
+		}
+
+		if n == 0 {
+			n = 1
+		}
+		leaves[id] = n
+		return n
+	}
+
+	outer := 0
+	var place func(id string, from, to float64, ring int)
+	place = func(id string, from, to float64, ring int) {
+		angle := (from + to) / 2
+		r := float64(ring) * step
+		pos[id] = Point{
+			X: opt.Origin.X + r*math.Cos(angle),
+			Y: opt.Origin.Y + r*math.Sin(angle),
+		}
+		if ring > outer {
+			outer = ring
+		}
+
+		next := from
+		for _, c := range f.children[id] {
+			span := (to - from) * float64(leaves[c]) / float64(leaves[id])
+			place(c, next, next+span, ring+1)
+			next += span
+		}
+	}
+
+	// Without root, or for the nodes not reachable from it, the trees share the rings
+	// around the center, beyond the tree of the root.
+	roots := f.roots
+	ring := 1
+	if opt.Root != "" && len(roots) > 0 && roots[0] == opt.Root {
+		count(opt.Root)
+		place(opt.Root, 0, 2*math.Pi, 0)
+		roots = roots[1:]
+		ring = outer + 1
+	}
+
+	total := 0
+	for _, r := range roots {
+		total += count(r)
+	}
+
+	next := 0.0
+	for _, r := range roots {
+		span := 2 * math.Pi * float64(leaves[r]) / float64(total)
+		place(r, next, next+span, ring)
+		next += span
+	}
+
+	return pos
+}
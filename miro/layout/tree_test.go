@@ -0,0 +1,92 @@
+package layout
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func square(ids ...string) []Node {
+	nodes := make([]Node, len(ids))
+	for i, id := range ids {
+		nodes[i] = Node{ID: id, Size: Size{Width: 10, Height: 10}}
+	}
+
+	return nodes
+}
+
+func TestTree(t *testing.T) {
+	nodes := square("root", "a", "b", "a1", "a2", "loose")
+	edges := []Edge{{"root", "a"}, {"root", "b"}, {"a", "a1"}, {"a", "a2"}, {"missing", "a"}}
+
+	tcs := map[string]struct {
+		nodes []Node
+		edges []Edge
+		opt   TreeOptions
+		want  Positions
+	}{
+		"top down": {nodes, edges, TreeOptions{LevelGap: 20, SiblingGap: 10}, Positions{
+			"root": {X: 25, Y: 5},
+			"a":    {X: 15, Y: 35}, "b": {X: 45, Y: 35},
+			"a1": {X: 5, Y: 65}, "a2": {X: 25, Y: 65},
+			"loose": {X: 65, Y: 5},
+		}},
+		"left right": {nodes, edges, TreeOptions{Direction: LeftRight, LevelGap: 20, SiblingGap: 10}, Positions{
+			"root": {X: 5, Y: 25},
+			"a":    {X: 35, Y: 15}, "b": {X: 35, Y: 45},
+			"a1": {X: 65, Y: 5}, "a2": {X: 65, Y: 25},
+			"loose": {X: 5, Y: 65},
+		}},
+		"cycle": {square("1", "2"), []Edge{{"1", "2"}, {"2", "1"}}, TreeOptions{LevelGap: 10}, Positions{
+			"1": {X: 5, Y: 5}, "2": {X: 5, Y: 25},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff(Tree(tc.nodes, tc.edges, tc.opt), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestRadial(t *testing.T) {
+	nodes := square("root", "a", "b", "c", "loose")
+	edges := []Edge{{"root", "a"}, {"root", "b"}, {"root", "c"}}
+	at := func(r, angle float64) Point {
+		return Point{X: r * math.Cos(angle), Y: r * math.Sin(angle)}
+	}
+
+	tcs := map[string]struct {
+		opt  RadialOptions
+		want Positions
+	}{
+		"root": {RadialOptions{Root: "root", RadiusStep: 100}, Positions{
+			"root": at(0, math.Pi),
+			"a":    at(100, math.Pi/3), "b": at(100, math.Pi), "c": at(100, 5*math.Pi/3),
+			"loose": at(200, math.Pi),
+		}},
+		"no root": {RadialOptions{RadiusStep: 100}, Positions{
+			"root": at(100, 3*math.Pi/4),
+			"a":    at(200, math.Pi/4), "b": at(200, 3*math.Pi/4), "c": at(200, 5*math.Pi/4),
+			"loose": at(100, 7*math.Pi/4),
+		}},
+		"zero value": {RadialOptions{Root: "root"}, Positions{
+			"root": at(0, math.Pi),
+			"a":    at(20, math.Pi/3), "b": at(20, math.Pi), "c": at(20, 5*math.Pi/3),
+			"loose": at(40, math.Pi),
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := Radial(nodes, edges, tc.opt)
+			if diff := cmp.Diff(got, tc.want, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// LineType represents the path of a line.
+type LineType string
+
+const (
+	LineTypeStraight LineType = "straight"
+	LineTypeElbowed  LineType = "elbowed"
+	LineTypeCurved   LineType = "curved"
+)
+
+// Arrowhead represents the shape drawn at an end of a line.
+type Arrowhead string
+
+const (
+	ArrowheadNone          Arrowhead = "none"
+	ArrowheadArrow         Arrowhead = "arrow"
+	ArrowheadOpenArrow     Arrowhead = "open_arrow"
+	ArrowheadOpaqueArrow   Arrowhead = "opaque_arrow"
+	ArrowheadCircle        Arrowhead = "circle"
+	ArrowheadOpaqueCircle  Arrowhead = "opaque_circle"
+	ArrowheadRhombus       Arrowhead = "rhombus"
+	ArrowheadOpaqueRhombus Arrowhead = "opaque_rhombus"
+)
+
+// SnapPosition represents the side of a widget an end of a line is attached to.
+type SnapPosition string
+
+const (
+	SnapAuto   SnapPosition = "auto"
+	SnapTop    SnapPosition = "top"
+	SnapRight  SnapPosition = "right"
+	SnapBottom SnapPosition = "bottom"
+	SnapLeft   SnapPosition = "left"
+)
+
+// Line object represents Miro Line.
+// An end bound to a widget follows the widget when it moves, an unbound end stays at its position.
+//
+// API doc: https://developers.miro.com/reference#line
+//
+//go:generate gomodifytags -file $GOFILE -struct Line -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Line -add-tags json -w -transform camelcase
+type Line struct {
+	WidgetBase
+	StartWidget   *LineEnd       `json:"startWidget,omitempty"`
+	EndWidget     *LineEnd       `json:"endWidget,omitempty"`
+	StartPosition *Point         `json:"startPosition,omitempty"`
+	EndPosition   *Point         `json:"endPosition,omitempty"`
+	Captions      []*LineCaption `json:"captions,omitempty"`
+	Style         *LineStyle     `json:"style,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// LineEnd represents the widget an end of a line is bound to.
+type LineEnd struct {
+	ID     string       `json:"id"`
+	SnapTo SnapPosition `json:"snapTo,omitempty"`
+}
+
+// LineCaption represents a caption of a line.
+// Position is the position of the caption along the line, from 0 at the start to 1 at the end.
+type LineCaption struct {
+	Text     string  `json:"text"`
+	Position float64 `json:"position,omitempty"`
+}
+
+// LineStyle represents the style of a line.
+//
+//go:generate gomodifytags -file $GOFILE -struct LineStyle -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct LineStyle -add-tags json -w -transform camelcase
+type LineStyle struct {
+	LineType      LineType  `json:"lineType,omitempty"`
+	LineStartType Arrowhead `json:"lineStartType,omitempty"`
+	LineEndType   Arrowhead `json:"lineEndType,omitempty"`
+	BorderColor   string    `json:"borderColor,omitempty"`
+	BorderStyle   string    `json:"borderStyle,omitempty"`
+	BorderWidth   float64   `json:"borderWidth,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// IsAttachedTo reports whether an end of the line is bound to the widget.
+func (l *Line) IsAttachedTo(widgetID string) bool {
+	return (l.StartWidget != nil && l.StartWidget.ID == widgetID) ||
+		(l.EndWidget != nil && l.EndWidget.ID == widgetID)
+}
+
+// Connect creates a line from a widget to another one, bound to both so that it follows them when they move.
+// A nil style uses the default style of Miro.
+func (s *WidgetsService) Connect(ctx context.Context, boardID, fromWidgetID, toWidgetID string, style *LineStyle) (*Line, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Connect")
+
+	w, resp, err := s.Create(ctx, boardID, &Line{
+		StartWidget: &LineEnd{ID: fromWidgetID},
+		EndWidget:   &LineEnd{ID: toWidgetID},
+		Style:       style,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	l, ok := w.(*Line)
+	if !ok {
+		return nil, resp, fmt.Errorf("widget %s is not a line, got:%s", w.Base().ID, w.Base().Type)
+	}
+
+	return l, resp, nil
+}
+
+// LinesAttachedTo lists the lines on the board with an end bound to the widget.
+func (s *WidgetsService) LinesAttachedTo(ctx context.Context, boardID, widgetID string) ([]*Line, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.LinesAttachedTo")
+
+	widgets, resp, err := s.List(ctx, boardID, WidgetTypeLine)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return AttachedLines(widgets, widgetID), resp, nil
+}
+
+// AttachedLines returns the lines among the widgets with an end bound to the widget.
+func AttachedLines(widgets []Widget, widgetID string) []*Line {
+	lines := []*Line{}
+	for _, w := range widgets {
+		if l, ok := w.(*Line); ok && l.IsAttachedTo(widgetID) {
+			lines = append(lines, l)
+		}
+	}
+
+	return lines
+}
+
+// UnmarshalJSON decodes the line keeping the members unknown to this package in Extra.
+func (l *Line) UnmarshalJSON(data []byte) error {
+	type alias Line
+	extra, err := unmarshalExtra(data, (*alias)(l))
+	if err != nil {
+		return err
+	}
+
+	l.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the line with the members in Extra.
+func (l Line) MarshalJSON() ([]byte, error) {
+	type alias Line
+	if l.Type == "" {
+		l.Type = WidgetTypeLine
+	}
+	return marshalExtra(alias(l), l.Extra)
+}
+
+// UnmarshalJSON decodes the line style keeping the members unknown to this package in Extra.
+func (s *LineStyle) UnmarshalJSON(data []byte) error {
+	type alias LineStyle
+	extra, err := unmarshalExtra(data, (*alias)(s))
+	if err != nil {
+		return err
+	}
+
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the line style with the members in Extra.
+func (s LineStyle) MarshalJSON() ([]byte, error) {
+	type alias LineStyle
+	return marshalExtra(alias(s), s.Extra)
+}
@@ -0,0 +1,112 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getLineJSON(id, from, to string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "line",
+	"startWidget": {"id": "%s", "snapTo": "right"},
+	"endWidget": {"id": "%s"},
+	"captions": [{"text": "depends on", "position": 0.5}],
+	"style": {"lineType": "elbowed", "lineEndType": "opaque_arrow", "borderWidth": 2}
+}`, id, from, to)
+}
+
+func getLine(id, from, to string) *Line {
+	return &Line{
+		WidgetBase:  WidgetBase{ID: id, Type: WidgetTypeLine},
+		StartWidget: &LineEnd{ID: from, SnapTo: SnapRight},
+		EndWidget:   &LineEnd{ID: to},
+		Captions:    []*LineCaption{{Text: "depends on", Position: 0.5}},
+		Style:       &LineStyle{LineType: LineTypeElbowed, LineEndType: ArrowheadOpaqueArrow, BorderWidth: 2},
+	}
+}
+
+func TestWidgetsService_Connect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		from  string
+		to    string
+		style *LineStyle
+		want  string
+	}{
+		"default style": {"1", "2", nil, `{"endWidget":{"id":"2"},"startWidget":{"id":"1"},"type":"line","x":0,"y":0}`},
+		"style": {"3", "4", &LineStyle{LineType: LineTypeCurved, LineEndType: ArrowheadArrow},
+			`{"endWidget":{"id":"4"},"startWidget":{"id":"3"},"style":{"lineType":"curved","lineEndType":"arrow"},"type":"line","x":0,"y":0}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.from, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+				got := map[string]json.RawMessage{}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				b, _ := json.Marshal(got)
+				if diff := cmp.Diff(string(b), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, getLineJSON("line", tc.from, tc.to))
+			})
+
+			got, _, err := client.Widgets.Connect(context.Background(), tc.from, tc.from, tc.to, tc.style)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, getLine("line", tc.from, tc.to)); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_LinesAttachedTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("widgetType") != string(WidgetTypeLine) {
+			t.Fatalf("query not expected, got:%s", r.URL.RawQuery)
+		}
+
+		fmt.Fprintf(w, `{"type": "collection", "data": [%s, %s, %s, {"id": "free", "type": "line", "startPosition": {"x": 1, "y": 2}}]}`,
+			getLineJSON("a", "1", "2"), getLineJSON("b", "2", "3"), getLineJSON("c", "3", "4"))
+	})
+
+	tcs := map[string]struct {
+		widgetID string
+		want     []*Line
+	}{
+		"start and end": {"2", []*Line{getLine("a", "1", "2"), getLine("b", "2", "3")}},
+		"end":           {"4", []*Line{getLine("c", "3", "4")}},
+		"none":          {"5", []*Line{}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, _, err := client.Widgets.LinesAttachedTo(context.Background(), "board", tc.widgetID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
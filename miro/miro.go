@@ -1,10 +1,52 @@
 package miro
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
 const (
 	baseURL          = "https://api.miro.com"
 	defaultUserAgent = "go-miro"
 )
 
+// APIVersion represents a version of Miro REST API.
+type APIVersion string
+
+const (
+	APIVersion1 APIVersion = "v1"
+	APIVersion2 APIVersion = "v2"
+)
+
 type service struct {
 	client *Client
 }
+
+// ListOptions specifies the optional parameters to list APIs that support offset pagination.
+type ListOptions struct {
+	Limit  int
+	Offset int
+}
+
+// addListOptions adds the parameters in opt as URL query parameters to path.
+func addListOptions(path string, opt *ListOptions) string {
+	if opt == nil {
+		return path
+	}
+
+	v := url.Values{}
+	if opt.Limit > 0 {
+		v.Set("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.Offset > 0 {
+		v.Set("offset", strconv.Itoa(opt.Offset))
+	}
+
+	if len(v) == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?%s", path, v.Encode())
+}
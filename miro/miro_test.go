@@ -22,3 +22,20 @@ func setup() (*Client, *http.ServeMux, string, func()) {
 	client.BaseURL = url
 	return client, mux, server.URL, server.Close
 }
+
+const (
+	baseURLPathV2 = "/v2"
+)
+
+// setupV2 is setup for the API v2, the mux handles the paths after /v2.
+func setupV2() (*Client, *http.ServeMux, string, func()) {
+	mux := http.NewServeMux()
+
+	apiHandler := http.NewServeMux()
+	apiHandler.Handle(baseURLPathV2+"/", http.StripPrefix(baseURLPathV2, mux))
+	server := httptest.NewServer(apiHandler)
+	client := NewClient(testAccessKey)
+	url, _ := url.Parse(server.URL)
+	client.BaseURL = url
+	return client, mux, server.URL, server.Close
+}
@@ -0,0 +1,2231 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	miro "github.com/Miro-Ecosystem/go-miro/miro"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditLogsAPI is a mock of AuditLogsAPI interface.
+type MockAuditLogsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogsAPIMockRecorder
+}
+
+// MockAuditLogsAPIMockRecorder is the mock recorder for MockAuditLogsAPI.
+type MockAuditLogsAPIMockRecorder struct {
+	mock *MockAuditLogsAPI
+}
+
+// NewMockAuditLogsAPI creates a new mock instance.
+func NewMockAuditLogsAPI(ctrl *gomock.Controller) *MockAuditLogsAPI {
+	mock := &MockAuditLogsAPI{ctrl: ctrl}
+	mock.recorder = &MockAuditLogsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogsAPI) EXPECT() *MockAuditLogsAPIMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAuditLogsAPI) Get(ctx context.Context) (*miro.AuditLog, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*miro.AuditLog)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuditLogsAPIMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuditLogsAPI)(nil).Get), ctx)
+}
+
+// MockAuthzInfoAPI is a mock of AuthzInfoAPI interface.
+type MockAuthzInfoAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthzInfoAPIMockRecorder
+}
+
+// MockAuthzInfoAPIMockRecorder is the mock recorder for MockAuthzInfoAPI.
+type MockAuthzInfoAPIMockRecorder struct {
+	mock *MockAuthzInfoAPI
+}
+
+// NewMockAuthzInfoAPI creates a new mock instance.
+func NewMockAuthzInfoAPI(ctrl *gomock.Controller) *MockAuthzInfoAPI {
+	mock := &MockAuthzInfoAPI{ctrl: ctrl}
+	mock.recorder = &MockAuthzInfoAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthzInfoAPI) EXPECT() *MockAuthzInfoAPIMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAuthzInfoAPI) Get(ctx context.Context) (*miro.AuthorizationInfo, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*miro.AuthorizationInfo)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuthzInfoAPIMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuthzInfoAPI)(nil).Get), ctx)
+}
+
+// MockBoardsAPI is a mock of BoardsAPI interface.
+type MockBoardsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardsAPIMockRecorder
+}
+
+// MockBoardsAPIMockRecorder is the mock recorder for MockBoardsAPI.
+type MockBoardsAPIMockRecorder struct {
+	mock *MockBoardsAPI
+}
+
+// NewMockBoardsAPI creates a new mock instance.
+func NewMockBoardsAPI(ctrl *gomock.Controller) *MockBoardsAPI {
+	mock := &MockBoardsAPI{ctrl: ctrl}
+	mock.recorder = &MockBoardsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardsAPI) EXPECT() *MockBoardsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBoardsAPI) Create(ctx context.Context, b *miro.CreateBoardRequest) (*miro.Board, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, b)
+	ret0, _ := ret[0].(*miro.Board)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardsAPIMockRecorder) Create(ctx, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardsAPI)(nil).Create), ctx, b)
+}
+
+// Delete mocks base method.
+func (m *MockBoardsAPI) Delete(ctx context.Context, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardsAPIMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardsAPI)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockBoardsAPI) Get(ctx context.Context, id string) (*miro.Board, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.Board)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBoardsAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoardsAPI)(nil).Get), ctx, id)
+}
+
+// GetCurrentUserBoards mocks base method.
+func (m *MockBoardsAPI) GetCurrentUserBoards(ctx context.Context, teamID string) (*miro.ListBoardsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUserBoards", ctx, teamID)
+	ret0, _ := ret[0].(*miro.ListBoardsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCurrentUserBoards indicates an expected call of GetCurrentUserBoards.
+func (mr *MockBoardsAPIMockRecorder) GetCurrentUserBoards(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUserBoards", reflect.TypeOf((*MockBoardsAPI)(nil).GetCurrentUserBoards), ctx, teamID)
+}
+
+// ListAllTeamBoards mocks base method.
+func (m *MockBoardsAPI) ListAllTeamBoards(ctx context.Context, teamID string) ([]*miro.Board, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTeamBoards", ctx, teamID)
+	ret0, _ := ret[0].([]*miro.Board)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllTeamBoards indicates an expected call of ListAllTeamBoards.
+func (mr *MockBoardsAPIMockRecorder) ListAllTeamBoards(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTeamBoards", reflect.TypeOf((*MockBoardsAPI)(nil).ListAllTeamBoards), ctx, teamID)
+}
+
+// ListTeamBoards mocks base method.
+func (m *MockBoardsAPI) ListTeamBoards(ctx context.Context, teamID string, opt *miro.ListOptions) (*miro.ListBoardsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamBoards", ctx, teamID, opt)
+	ret0, _ := ret[0].(*miro.ListBoardsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTeamBoards indicates an expected call of ListTeamBoards.
+func (mr *MockBoardsAPIMockRecorder) ListTeamBoards(ctx, teamID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamBoards", reflect.TypeOf((*MockBoardsAPI)(nil).ListTeamBoards), ctx, teamID, opt)
+}
+
+// Share mocks base method.
+func (m *MockBoardsAPI) Share(ctx context.Context, id string, request *miro.ShareBoardRequest) (*miro.ListBoardsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, id, request)
+	ret0, _ := ret[0].(*miro.ListBoardsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockBoardsAPIMockRecorder) Share(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockBoardsAPI)(nil).Share), ctx, id, request)
+}
+
+// ShareWithRoles mocks base method.
+func (m *MockBoardsAPI) ShareWithRoles(ctx context.Context, id string, request *miro.ShareBoardWithRolesRequest) ([]*miro.BoardUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareWithRoles", ctx, id, request)
+	ret0, _ := ret[0].([]*miro.BoardUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ShareWithRoles indicates an expected call of ShareWithRoles.
+func (mr *MockBoardsAPIMockRecorder) ShareWithRoles(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareWithRoles", reflect.TypeOf((*MockBoardsAPI)(nil).ShareWithRoles), ctx, id, request)
+}
+
+// Update mocks base method.
+func (m *MockBoardsAPI) Update(ctx context.Context, id string, b *miro.UpdateBoardRequest) (*miro.Board, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, b)
+	ret0, _ := ret[0].(*miro.Board)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBoardsAPIMockRecorder) Update(ctx, id, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardsAPI)(nil).Update), ctx, id, b)
+}
+
+// MockBoardUserConnectionAPI is a mock of BoardUserConnectionAPI interface.
+type MockBoardUserConnectionAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardUserConnectionAPIMockRecorder
+}
+
+// MockBoardUserConnectionAPIMockRecorder is the mock recorder for MockBoardUserConnectionAPI.
+type MockBoardUserConnectionAPIMockRecorder struct {
+	mock *MockBoardUserConnectionAPI
+}
+
+// NewMockBoardUserConnectionAPI creates a new mock instance.
+func NewMockBoardUserConnectionAPI(ctrl *gomock.Controller) *MockBoardUserConnectionAPI {
+	mock := &MockBoardUserConnectionAPI{ctrl: ctrl}
+	mock.recorder = &MockBoardUserConnectionAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardUserConnectionAPI) EXPECT() *MockBoardUserConnectionAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBoardUserConnectionAPI) Delete(ctx context.Context, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardUserConnectionAPIMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockBoardUserConnectionAPI) Get(ctx context.Context, id string) (*miro.BoardUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.BoardUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBoardUserConnectionAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).Get), ctx, id)
+}
+
+// ListAllMembers mocks base method.
+func (m *MockBoardUserConnectionAPI) ListAllMembers(ctx context.Context, boardID string) ([]*miro.BoardUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllMembers", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.BoardUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllMembers indicates an expected call of ListAllMembers.
+func (mr *MockBoardUserConnectionAPIMockRecorder) ListAllMembers(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllMembers", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).ListAllMembers), ctx, boardID)
+}
+
+// ListMembers mocks base method.
+func (m *MockBoardUserConnectionAPI) ListMembers(ctx context.Context, boardID string, opt *miro.ListOptions) (*miro.ListBoardUserConnectionsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, boardID, opt)
+	ret0, _ := ret[0].(*miro.ListBoardUserConnectionsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockBoardUserConnectionAPIMockRecorder) ListMembers(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).ListMembers), ctx, boardID, opt)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockBoardUserConnectionAPI) TransferOwnership(ctx context.Context, boardID, userID string) (*miro.BoardUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, boardID, userID)
+	ret0, _ := ret[0].(*miro.BoardUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockBoardUserConnectionAPIMockRecorder) TransferOwnership(ctx, boardID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).TransferOwnership), ctx, boardID, userID)
+}
+
+// Updates mocks base method.
+func (m *MockBoardUserConnectionAPI) Updates(ctx context.Context, id string, request *miro.UpdateBoardUserConnectionRequest) (*miro.BoardUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, id, request)
+	ret0, _ := ret[0].(*miro.BoardUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockBoardUserConnectionAPIMockRecorder) Updates(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockBoardUserConnectionAPI)(nil).Updates), ctx, id, request)
+}
+
+// MockCommentsAPI is a mock of CommentsAPI interface.
+type MockCommentsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentsAPIMockRecorder
+}
+
+// MockCommentsAPIMockRecorder is the mock recorder for MockCommentsAPI.
+type MockCommentsAPIMockRecorder struct {
+	mock *MockCommentsAPI
+}
+
+// NewMockCommentsAPI creates a new mock instance.
+func NewMockCommentsAPI(ctrl *gomock.Controller) *MockCommentsAPI {
+	mock := &MockCommentsAPI{ctrl: ctrl}
+	mock.recorder = &MockCommentsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentsAPI) EXPECT() *MockCommentsAPIMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCommentsAPI) Get(ctx context.Context, boardID, threadID string) (*miro.CommentThread, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, threadID)
+	ret0, _ := ret[0].(*miro.CommentThread)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCommentsAPIMockRecorder) Get(ctx, boardID, threadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentsAPI)(nil).Get), ctx, boardID, threadID)
+}
+
+// List mocks base method.
+func (m *MockCommentsAPI) List(ctx context.Context, boardID string) ([]*miro.CommentThread, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.CommentThread)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentsAPIMockRecorder) List(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentsAPI)(nil).List), ctx, boardID)
+}
+
+// ListByWidget mocks base method.
+func (m *MockCommentsAPI) ListByWidget(ctx context.Context, boardID, widgetID string) ([]*miro.CommentThread, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWidget", ctx, boardID, widgetID)
+	ret0, _ := ret[0].([]*miro.CommentThread)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByWidget indicates an expected call of ListByWidget.
+func (mr *MockCommentsAPIMockRecorder) ListByWidget(ctx, boardID, widgetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWidget", reflect.TypeOf((*MockCommentsAPI)(nil).ListByWidget), ctx, boardID, widgetID)
+}
+
+// Reopen mocks base method.
+func (m *MockCommentsAPI) Reopen(ctx context.Context, boardID, threadID string) (*miro.CommentThread, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, boardID, threadID)
+	ret0, _ := ret[0].(*miro.CommentThread)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockCommentsAPIMockRecorder) Reopen(ctx, boardID, threadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockCommentsAPI)(nil).Reopen), ctx, boardID, threadID)
+}
+
+// Reply mocks base method.
+func (m *MockCommentsAPI) Reply(ctx context.Context, boardID, threadID, text string) (*miro.Comment, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reply", ctx, boardID, threadID, text)
+	ret0, _ := ret[0].(*miro.Comment)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reply indicates an expected call of Reply.
+func (mr *MockCommentsAPIMockRecorder) Reply(ctx, boardID, threadID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reply", reflect.TypeOf((*MockCommentsAPI)(nil).Reply), ctx, boardID, threadID, text)
+}
+
+// Resolve mocks base method.
+func (m *MockCommentsAPI) Resolve(ctx context.Context, boardID, threadID string) (*miro.CommentThread, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, boardID, threadID)
+	ret0, _ := ret[0].(*miro.CommentThread)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockCommentsAPIMockRecorder) Resolve(ctx, boardID, threadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockCommentsAPI)(nil).Resolve), ctx, boardID, threadID)
+}
+
+// MockOrganizationsAPI is a mock of OrganizationsAPI interface.
+type MockOrganizationsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationsAPIMockRecorder
+}
+
+// MockOrganizationsAPIMockRecorder is the mock recorder for MockOrganizationsAPI.
+type MockOrganizationsAPIMockRecorder struct {
+	mock *MockOrganizationsAPI
+}
+
+// NewMockOrganizationsAPI creates a new mock instance.
+func NewMockOrganizationsAPI(ctrl *gomock.Controller) *MockOrganizationsAPI {
+	mock := &MockOrganizationsAPI{ctrl: ctrl}
+	mock.recorder = &MockOrganizationsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationsAPI) EXPECT() *MockOrganizationsAPIMockRecorder {
+	return m.recorder
+}
+
+// DeactivateMember mocks base method.
+func (m *MockOrganizationsAPI) DeactivateMember(ctx context.Context, id, memberID string) (*miro.OrganizationMember, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateMember", ctx, id, memberID)
+	ret0, _ := ret[0].(*miro.OrganizationMember)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeactivateMember indicates an expected call of DeactivateMember.
+func (mr *MockOrganizationsAPIMockRecorder) DeactivateMember(ctx, id, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateMember", reflect.TypeOf((*MockOrganizationsAPI)(nil).DeactivateMember), ctx, id, memberID)
+}
+
+// Get mocks base method.
+func (m *MockOrganizationsAPI) Get(ctx context.Context, id string) (*miro.Organization, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.Organization)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOrganizationsAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrganizationsAPI)(nil).Get), ctx, id)
+}
+
+// GetMember mocks base method.
+func (m *MockOrganizationsAPI) GetMember(ctx context.Context, id, memberID string) (*miro.OrganizationMember, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, id, memberID)
+	ret0, _ := ret[0].(*miro.OrganizationMember)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockOrganizationsAPIMockRecorder) GetMember(ctx, id, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockOrganizationsAPI)(nil).GetMember), ctx, id, memberID)
+}
+
+// ListAllMembers mocks base method.
+func (m *MockOrganizationsAPI) ListAllMembers(ctx context.Context, id string, opt *miro.ListOrganizationMembersOptions) ([]*miro.OrganizationMember, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllMembers", ctx, id, opt)
+	ret0, _ := ret[0].([]*miro.OrganizationMember)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllMembers indicates an expected call of ListAllMembers.
+func (mr *MockOrganizationsAPIMockRecorder) ListAllMembers(ctx, id, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllMembers", reflect.TypeOf((*MockOrganizationsAPI)(nil).ListAllMembers), ctx, id, opt)
+}
+
+// ListAllTeams mocks base method.
+func (m *MockOrganizationsAPI) ListAllTeams(ctx context.Context, id string) ([]*miro.Team, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTeams", ctx, id)
+	ret0, _ := ret[0].([]*miro.Team)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllTeams indicates an expected call of ListAllTeams.
+func (mr *MockOrganizationsAPIMockRecorder) ListAllTeams(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTeams", reflect.TypeOf((*MockOrganizationsAPI)(nil).ListAllTeams), ctx, id)
+}
+
+// ListMembers mocks base method.
+func (m *MockOrganizationsAPI) ListMembers(ctx context.Context, id string, opt *miro.ListOrganizationMembersOptions) (*miro.ListOrganizationMembersResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, id, opt)
+	ret0, _ := ret[0].(*miro.ListOrganizationMembersResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockOrganizationsAPIMockRecorder) ListMembers(ctx, id, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockOrganizationsAPI)(nil).ListMembers), ctx, id, opt)
+}
+
+// ListTeams mocks base method.
+func (m *MockOrganizationsAPI) ListTeams(ctx context.Context, id string, opt *miro.CursorOptions) (*miro.ListTeamsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeams", ctx, id, opt)
+	ret0, _ := ret[0].(*miro.ListTeamsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockOrganizationsAPIMockRecorder) ListTeams(ctx, id, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockOrganizationsAPI)(nil).ListTeams), ctx, id, opt)
+}
+
+// ReactivateMember mocks base method.
+func (m *MockOrganizationsAPI) ReactivateMember(ctx context.Context, id, memberID string) (*miro.OrganizationMember, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateMember", ctx, id, memberID)
+	ret0, _ := ret[0].(*miro.OrganizationMember)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReactivateMember indicates an expected call of ReactivateMember.
+func (mr *MockOrganizationsAPIMockRecorder) ReactivateMember(ctx, id, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateMember", reflect.TypeOf((*MockOrganizationsAPI)(nil).ReactivateMember), ctx, id, memberID)
+}
+
+// MockPicturesAPI is a mock of PicturesAPI interface.
+type MockPicturesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPicturesAPIMockRecorder
+}
+
+// MockPicturesAPIMockRecorder is the mock recorder for MockPicturesAPI.
+type MockPicturesAPIMockRecorder struct {
+	mock *MockPicturesAPI
+}
+
+// NewMockPicturesAPI creates a new mock instance.
+func NewMockPicturesAPI(ctrl *gomock.Controller) *MockPicturesAPI {
+	mock := &MockPicturesAPI{ctrl: ctrl}
+	mock.recorder = &MockPicturesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPicturesAPI) EXPECT() *MockPicturesAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPicturesAPI) Delete(ctx context.Context, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPicturesAPIMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPicturesAPI)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockPicturesAPI) Get(ctx context.Context, id string) (*miro.Picture, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.Picture)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPicturesAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPicturesAPI)(nil).Get), ctx, id)
+}
+
+// Upsert mocks base method.
+func (m *MockPicturesAPI) Upsert(ctx context.Context, id string, request *miro.UpsertPictureRequest) (*miro.Picture, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, id, request)
+	ret0, _ := ret[0].(*miro.Picture)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPicturesAPIMockRecorder) Upsert(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPicturesAPI)(nil).Upsert), ctx, id, request)
+}
+
+// MockTagsAPI is a mock of TagsAPI interface.
+type MockTagsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsAPIMockRecorder
+}
+
+// MockTagsAPIMockRecorder is the mock recorder for MockTagsAPI.
+type MockTagsAPIMockRecorder struct {
+	mock *MockTagsAPI
+}
+
+// NewMockTagsAPI creates a new mock instance.
+func NewMockTagsAPI(ctrl *gomock.Controller) *MockTagsAPI {
+	mock := &MockTagsAPI{ctrl: ctrl}
+	mock.recorder = &MockTagsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagsAPI) EXPECT() *MockTagsAPIMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockTagsAPI) Attach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, boardID, tagID}
+	for _, a := range widgetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Attach", varargs...)
+	ret0, _ := ret[0].(*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockTagsAPIMockRecorder) Attach(ctx, boardID, tagID interface{}, widgetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, boardID, tagID}, widgetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockTagsAPI)(nil).Attach), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockTagsAPI) Create(ctx context.Context, boardID string, request *miro.TagRequest) (*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardID, request)
+	ret0, _ := ret[0].(*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagsAPIMockRecorder) Create(ctx, boardID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagsAPI)(nil).Create), ctx, boardID, request)
+}
+
+// Delete mocks base method.
+func (m *MockTagsAPI) Delete(ctx context.Context, boardID, tagID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, tagID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagsAPIMockRecorder) Delete(ctx, boardID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagsAPI)(nil).Delete), ctx, boardID, tagID)
+}
+
+// Detach mocks base method.
+func (m *MockTagsAPI) Detach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, boardID, tagID}
+	for _, a := range widgetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Detach", varargs...)
+	ret0, _ := ret[0].(*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockTagsAPIMockRecorder) Detach(ctx, boardID, tagID interface{}, widgetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, boardID, tagID}, widgetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockTagsAPI)(nil).Detach), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockTagsAPI) Get(ctx context.Context, boardID, tagID string) (*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, tagID)
+	ret0, _ := ret[0].(*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTagsAPIMockRecorder) Get(ctx, boardID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagsAPI)(nil).Get), ctx, boardID, tagID)
+}
+
+// List mocks base method.
+func (m *MockTagsAPI) List(ctx context.Context, boardID string) ([]*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTagsAPIMockRecorder) List(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTagsAPI)(nil).List), ctx, boardID)
+}
+
+// Update mocks base method.
+func (m *MockTagsAPI) Update(ctx context.Context, boardID, tagID string, request *miro.TagRequest) (*miro.Tag, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, tagID, request)
+	ret0, _ := ret[0].(*miro.Tag)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagsAPIMockRecorder) Update(ctx, boardID, tagID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagsAPI)(nil).Update), ctx, boardID, tagID, request)
+}
+
+// MockTeamsAPI is a mock of TeamsAPI interface.
+type MockTeamsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamsAPIMockRecorder
+}
+
+// MockTeamsAPIMockRecorder is the mock recorder for MockTeamsAPI.
+type MockTeamsAPIMockRecorder struct {
+	mock *MockTeamsAPI
+}
+
+// NewMockTeamsAPI creates a new mock instance.
+func NewMockTeamsAPI(ctrl *gomock.Controller) *MockTeamsAPI {
+	mock := &MockTeamsAPI{ctrl: ctrl}
+	mock.recorder = &MockTeamsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamsAPI) EXPECT() *MockTeamsAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTeamsAPI) Create(ctx context.Context, orgID string, request *miro.CreateTeamRequest) (*miro.Team, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, orgID, request)
+	ret0, _ := ret[0].(*miro.Team)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamsAPIMockRecorder) Create(ctx, orgID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamsAPI)(nil).Create), ctx, orgID, request)
+}
+
+// Delete mocks base method.
+func (m *MockTeamsAPI) Delete(ctx context.Context, orgID, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, orgID, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamsAPIMockRecorder) Delete(ctx, orgID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamsAPI)(nil).Delete), ctx, orgID, id)
+}
+
+// Get mocks base method.
+func (m *MockTeamsAPI) Get(ctx context.Context, id string) (*miro.Team, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.Team)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTeamsAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTeamsAPI)(nil).Get), ctx, id)
+}
+
+// GetCurrentUserConnection mocks base method.
+func (m *MockTeamsAPI) GetCurrentUserConnection(ctx context.Context, id string) (*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUserConnection", ctx, id)
+	ret0, _ := ret[0].(*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCurrentUserConnection indicates an expected call of GetCurrentUserConnection.
+func (mr *MockTeamsAPIMockRecorder) GetCurrentUserConnection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUserConnection", reflect.TypeOf((*MockTeamsAPI)(nil).GetCurrentUserConnection), ctx, id)
+}
+
+// GetSettings mocks base method.
+func (m *MockTeamsAPI) GetSettings(ctx context.Context, orgID, id string) (*miro.TeamSettings, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, orgID, id)
+	ret0, _ := ret[0].(*miro.TeamSettings)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MockTeamsAPIMockRecorder) GetSettings(ctx, orgID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockTeamsAPI)(nil).GetSettings), ctx, orgID, id)
+}
+
+// Invite mocks base method.
+func (m *MockTeamsAPI) Invite(ctx context.Context, id, email string) ([]*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, id, email)
+	ret0, _ := ret[0].([]*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockTeamsAPIMockRecorder) Invite(ctx, id, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockTeamsAPI)(nil).Invite), ctx, id, email)
+}
+
+// InviteMany mocks base method.
+func (m *MockTeamsAPI) InviteMany(ctx context.Context, id string, request *miro.InviteManyRequest) ([]*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteMany", ctx, id, request)
+	ret0, _ := ret[0].([]*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InviteMany indicates an expected call of InviteMany.
+func (mr *MockTeamsAPIMockRecorder) InviteMany(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteMany", reflect.TypeOf((*MockTeamsAPI)(nil).InviteMany), ctx, id, request)
+}
+
+// InviteWithRoles mocks base method.
+func (m *MockTeamsAPI) InviteWithRoles(ctx context.Context, id string, request *miro.InviteWithRolesRequest) ([]*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteWithRoles", ctx, id, request)
+	ret0, _ := ret[0].([]*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InviteWithRoles indicates an expected call of InviteWithRoles.
+func (mr *MockTeamsAPIMockRecorder) InviteWithRoles(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteWithRoles", reflect.TypeOf((*MockTeamsAPI)(nil).InviteWithRoles), ctx, id, request)
+}
+
+// ListAllByOrganization mocks base method.
+func (m *MockTeamsAPI) ListAllByOrganization(ctx context.Context, orgID string) ([]*miro.Team, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllByOrganization", ctx, orgID)
+	ret0, _ := ret[0].([]*miro.Team)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllByOrganization indicates an expected call of ListAllByOrganization.
+func (mr *MockTeamsAPIMockRecorder) ListAllByOrganization(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllByOrganization", reflect.TypeOf((*MockTeamsAPI)(nil).ListAllByOrganization), ctx, orgID)
+}
+
+// ListAllTeamMembers mocks base method.
+func (m *MockTeamsAPI) ListAllTeamMembers(ctx context.Context, id string) ([]*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTeamMembers", ctx, id)
+	ret0, _ := ret[0].([]*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAllTeamMembers indicates an expected call of ListAllTeamMembers.
+func (mr *MockTeamsAPIMockRecorder) ListAllTeamMembers(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTeamMembers", reflect.TypeOf((*MockTeamsAPI)(nil).ListAllTeamMembers), ctx, id)
+}
+
+// ListByOrganization mocks base method.
+func (m *MockTeamsAPI) ListByOrganization(ctx context.Context, orgID string, opt *miro.CursorOptions) (*miro.ListTeamsResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrganization", ctx, orgID, opt)
+	ret0, _ := ret[0].(*miro.ListTeamsResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByOrganization indicates an expected call of ListByOrganization.
+func (mr *MockTeamsAPIMockRecorder) ListByOrganization(ctx, orgID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrganization", reflect.TypeOf((*MockTeamsAPI)(nil).ListByOrganization), ctx, orgID, opt)
+}
+
+// ListTeamMembers mocks base method.
+func (m *MockTeamsAPI) ListTeamMembers(ctx context.Context, id string, opt *miro.ListOptions) (*miro.ListTeamMembersResponse, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamMembers", ctx, id, opt)
+	ret0, _ := ret[0].(*miro.ListTeamMembersResponse)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockTeamsAPIMockRecorder) ListTeamMembers(ctx, id, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockTeamsAPI)(nil).ListTeamMembers), ctx, id, opt)
+}
+
+// Update mocks base method.
+func (m *MockTeamsAPI) Update(ctx context.Context, id string, request *miro.UpdateTeamRequest) (*miro.Team, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, request)
+	ret0, _ := ret[0].(*miro.Team)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTeamsAPIMockRecorder) Update(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeamsAPI)(nil).Update), ctx, id, request)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockTeamsAPI) UpdateSettings(ctx context.Context, orgID, id string, request *miro.TeamSettings) (*miro.TeamSettings, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, orgID, id, request)
+	ret0, _ := ret[0].(*miro.TeamSettings)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockTeamsAPIMockRecorder) UpdateSettings(ctx, orgID, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockTeamsAPI)(nil).UpdateSettings), ctx, orgID, id, request)
+}
+
+// MockTeamUserConnectionAPI is a mock of TeamUserConnectionAPI interface.
+type MockTeamUserConnectionAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamUserConnectionAPIMockRecorder
+}
+
+// MockTeamUserConnectionAPIMockRecorder is the mock recorder for MockTeamUserConnectionAPI.
+type MockTeamUserConnectionAPIMockRecorder struct {
+	mock *MockTeamUserConnectionAPI
+}
+
+// NewMockTeamUserConnectionAPI creates a new mock instance.
+func NewMockTeamUserConnectionAPI(ctrl *gomock.Controller) *MockTeamUserConnectionAPI {
+	mock := &MockTeamUserConnectionAPI{ctrl: ctrl}
+	mock.recorder = &MockTeamUserConnectionAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamUserConnectionAPI) EXPECT() *MockTeamUserConnectionAPIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTeamUserConnectionAPI) Delete(ctx context.Context, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamUserConnectionAPIMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamUserConnectionAPI)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockTeamUserConnectionAPI) Get(ctx context.Context, id string) (*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTeamUserConnectionAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTeamUserConnectionAPI)(nil).Get), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockTeamUserConnectionAPI) Update(ctx context.Context, id string, request *miro.UpdateTeamUserConnectionRequest) (*miro.TeamUserConnection, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, request)
+	ret0, _ := ret[0].(*miro.TeamUserConnection)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTeamUserConnectionAPIMockRecorder) Update(ctx, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeamUserConnectionAPI)(nil).Update), ctx, id, request)
+}
+
+// MockUsersAPI is a mock of UsersAPI interface.
+type MockUsersAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersAPIMockRecorder
+}
+
+// MockUsersAPIMockRecorder is the mock recorder for MockUsersAPI.
+type MockUsersAPIMockRecorder struct {
+	mock *MockUsersAPI
+}
+
+// NewMockUsersAPI creates a new mock instance.
+func NewMockUsersAPI(ctrl *gomock.Controller) *MockUsersAPI {
+	mock := &MockUsersAPI{ctrl: ctrl}
+	mock.recorder = &MockUsersAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersAPI) EXPECT() *MockUsersAPIMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUsersAPI) Get(ctx context.Context, id string) (*miro.User, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.User)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUsersAPIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsersAPI)(nil).Get), ctx, id)
+}
+
+// GetCurrentUser mocks base method.
+func (m *MockUsersAPI) GetCurrentUser(ctx context.Context) (*miro.User, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx)
+	ret0, _ := ret[0].(*miro.User)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *MockUsersAPIMockRecorder) GetCurrentUser(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockUsersAPI)(nil).GetCurrentUser), ctx)
+}
+
+// UpdateCurrentUser mocks base method.
+func (m *MockUsersAPI) UpdateCurrentUser(ctx context.Context, request *miro.UpdateCurrentUserRequest) (*miro.User, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrentUser", ctx, request)
+	ret0, _ := ret[0].(*miro.User)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateCurrentUser indicates an expected call of UpdateCurrentUser.
+func (mr *MockUsersAPIMockRecorder) UpdateCurrentUser(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentUser", reflect.TypeOf((*MockUsersAPI)(nil).UpdateCurrentUser), ctx, request)
+}
+
+// MockWidgetsAPI is a mock of WidgetsAPI interface.
+type MockWidgetsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockWidgetsAPIMockRecorder
+}
+
+// MockWidgetsAPIMockRecorder is the mock recorder for MockWidgetsAPI.
+type MockWidgetsAPIMockRecorder struct {
+	mock *MockWidgetsAPI
+}
+
+// NewMockWidgetsAPI creates a new mock instance.
+func NewMockWidgetsAPI(ctrl *gomock.Controller) *MockWidgetsAPI {
+	mock := &MockWidgetsAPI{ctrl: ctrl}
+	mock.recorder = &MockWidgetsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWidgetsAPI) EXPECT() *MockWidgetsAPIMockRecorder {
+	return m.recorder
+}
+
+// Connect mocks base method.
+func (m *MockWidgetsAPI) Connect(ctx context.Context, boardID, fromWidgetID, toWidgetID string, style *miro.LineStyle) (*miro.Line, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", ctx, boardID, fromWidgetID, toWidgetID, style)
+	ret0, _ := ret[0].(*miro.Line)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockWidgetsAPIMockRecorder) Connect(ctx, boardID, fromWidgetID, toWidgetID, style interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockWidgetsAPI)(nil).Connect), ctx, boardID, fromWidgetID, toWidgetID, style)
+}
+
+// Create mocks base method.
+func (m *MockWidgetsAPI) Create(ctx context.Context, boardID string, w miro.Widget) (miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardID, w)
+	ret0, _ := ret[0].(miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWidgetsAPIMockRecorder) Create(ctx, boardID, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWidgetsAPI)(nil).Create), ctx, boardID, w)
+}
+
+// CreateMany mocks base method.
+func (m *MockWidgetsAPI) CreateMany(ctx context.Context, boardID string, widgets []miro.Widget, opt *miro.BulkOptions) (miro.BulkResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, boardID, widgets, opt)
+	ret0, _ := ret[0].(miro.BulkResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockWidgetsAPIMockRecorder) CreateMany(ctx, boardID, widgets, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockWidgetsAPI)(nil).CreateMany), ctx, boardID, widgets, opt)
+}
+
+// Delete mocks base method.
+func (m *MockWidgetsAPI) Delete(ctx context.Context, boardID, widgetID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, widgetID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWidgetsAPIMockRecorder) Delete(ctx, boardID, widgetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWidgetsAPI)(nil).Delete), ctx, boardID, widgetID)
+}
+
+// DeleteMany mocks base method.
+func (m *MockWidgetsAPI) DeleteMany(ctx context.Context, boardID string, widgetIDs []string, opt *miro.BulkOptions) (miro.BulkResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, boardID, widgetIDs, opt)
+	ret0, _ := ret[0].(miro.BulkResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockWidgetsAPIMockRecorder) DeleteMany(ctx, boardID, widgetIDs, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockWidgetsAPI)(nil).DeleteMany), ctx, boardID, widgetIDs, opt)
+}
+
+// FrameTree mocks base method.
+func (m *MockWidgetsAPI) FrameTree(ctx context.Context, boardID string) (*miro.FrameNode, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FrameTree", ctx, boardID)
+	ret0, _ := ret[0].(*miro.FrameNode)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FrameTree indicates an expected call of FrameTree.
+func (mr *MockWidgetsAPIMockRecorder) FrameTree(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FrameTree", reflect.TypeOf((*MockWidgetsAPI)(nil).FrameTree), ctx, boardID)
+}
+
+// Get mocks base method.
+func (m *MockWidgetsAPI) Get(ctx context.Context, boardID, widgetID string) (miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, widgetID)
+	ret0, _ := ret[0].(miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWidgetsAPIMockRecorder) Get(ctx, boardID, widgetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWidgetsAPI)(nil).Get), ctx, boardID, widgetID)
+}
+
+// GetFrame mocks base method.
+func (m *MockWidgetsAPI) GetFrame(ctx context.Context, boardID, frameID string) (*miro.Frame, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrame", ctx, boardID, frameID)
+	ret0, _ := ret[0].(*miro.Frame)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFrame indicates an expected call of GetFrame.
+func (mr *MockWidgetsAPIMockRecorder) GetFrame(ctx, boardID, frameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrame", reflect.TypeOf((*MockWidgetsAPI)(nil).GetFrame), ctx, boardID, frameID)
+}
+
+// LinesAttachedTo mocks base method.
+func (m *MockWidgetsAPI) LinesAttachedTo(ctx context.Context, boardID, widgetID string) ([]*miro.Line, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinesAttachedTo", ctx, boardID, widgetID)
+	ret0, _ := ret[0].([]*miro.Line)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LinesAttachedTo indicates an expected call of LinesAttachedTo.
+func (mr *MockWidgetsAPIMockRecorder) LinesAttachedTo(ctx, boardID, widgetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinesAttachedTo", reflect.TypeOf((*MockWidgetsAPI)(nil).LinesAttachedTo), ctx, boardID, widgetID)
+}
+
+// List mocks base method.
+func (m *MockWidgetsAPI) List(ctx context.Context, boardID string, widgetType miro.WidgetType) ([]miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID, widgetType)
+	ret0, _ := ret[0].([]miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockWidgetsAPIMockRecorder) List(ctx, boardID, widgetType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWidgetsAPI)(nil).List), ctx, boardID, widgetType)
+}
+
+// ListByTag mocks base method.
+func (m *MockWidgetsAPI) ListByTag(ctx context.Context, boardID, tagID string, widgetType miro.WidgetType) ([]miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTag", ctx, boardID, tagID, widgetType)
+	ret0, _ := ret[0].([]miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByTag indicates an expected call of ListByTag.
+func (mr *MockWidgetsAPIMockRecorder) ListByTag(ctx, boardID, tagID, widgetType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTag", reflect.TypeOf((*MockWidgetsAPI)(nil).ListByTag), ctx, boardID, tagID, widgetType)
+}
+
+// ListFrameChildren mocks base method.
+func (m *MockWidgetsAPI) ListFrameChildren(ctx context.Context, boardID, frameID string) ([]miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFrameChildren", ctx, boardID, frameID)
+	ret0, _ := ret[0].([]miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFrameChildren indicates an expected call of ListFrameChildren.
+func (mr *MockWidgetsAPIMockRecorder) ListFrameChildren(ctx, boardID, frameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFrameChildren", reflect.TypeOf((*MockWidgetsAPI)(nil).ListFrameChildren), ctx, boardID, frameID)
+}
+
+// Move mocks base method.
+func (m *MockWidgetsAPI) Move(ctx context.Context, boardID, widgetID string, p miro.Point) (miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, boardID, widgetID, p)
+	ret0, _ := ret[0].(miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockWidgetsAPIMockRecorder) Move(ctx, boardID, widgetID, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockWidgetsAPI)(nil).Move), ctx, boardID, widgetID, p)
+}
+
+// MoveIntoFrame mocks base method.
+func (m *MockWidgetsAPI) MoveIntoFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*miro.Frame, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, boardID, frameID}
+	for _, a := range widgetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveIntoFrame", varargs...)
+	ret0, _ := ret[0].(*miro.Frame)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveIntoFrame indicates an expected call of MoveIntoFrame.
+func (mr *MockWidgetsAPIMockRecorder) MoveIntoFrame(ctx, boardID, frameID interface{}, widgetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, boardID, frameID}, widgetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveIntoFrame", reflect.TypeOf((*MockWidgetsAPI)(nil).MoveIntoFrame), varargs...)
+}
+
+// MoveMany mocks base method.
+func (m *MockWidgetsAPI) MoveMany(ctx context.Context, boardID string, positions map[string]miro.Point) ([]miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMany", ctx, boardID, positions)
+	ret0, _ := ret[0].([]miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveMany indicates an expected call of MoveMany.
+func (mr *MockWidgetsAPIMockRecorder) MoveMany(ctx, boardID, positions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMany", reflect.TypeOf((*MockWidgetsAPI)(nil).MoveMany), ctx, boardID, positions)
+}
+
+// MoveOutOfFrame mocks base method.
+func (m *MockWidgetsAPI) MoveOutOfFrame(ctx context.Context, boardID, frameID string, widgetIDs ...string) (*miro.Frame, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, boardID, frameID}
+	for _, a := range widgetIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveOutOfFrame", varargs...)
+	ret0, _ := ret[0].(*miro.Frame)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveOutOfFrame indicates an expected call of MoveOutOfFrame.
+func (mr *MockWidgetsAPIMockRecorder) MoveOutOfFrame(ctx, boardID, frameID interface{}, widgetIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, boardID, frameID}, widgetIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOutOfFrame", reflect.TypeOf((*MockWidgetsAPI)(nil).MoveOutOfFrame), varargs...)
+}
+
+// PlaceInFrame mocks base method.
+func (m *MockWidgetsAPI) PlaceInFrame(ctx context.Context, boardID, frameID, widgetID string, p miro.Point) (*miro.Frame, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceInFrame", ctx, boardID, frameID, widgetID, p)
+	ret0, _ := ret[0].(*miro.Frame)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PlaceInFrame indicates an expected call of PlaceInFrame.
+func (mr *MockWidgetsAPIMockRecorder) PlaceInFrame(ctx, boardID, frameID, widgetID, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceInFrame", reflect.TypeOf((*MockWidgetsAPI)(nil).PlaceInFrame), ctx, boardID, frameID, widgetID, p)
+}
+
+// Update mocks base method.
+func (m *MockWidgetsAPI) Update(ctx context.Context, boardID, widgetID string, w miro.Widget) (miro.Widget, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, widgetID, w)
+	ret0, _ := ret[0].(miro.Widget)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWidgetsAPIMockRecorder) Update(ctx, boardID, widgetID, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWidgetsAPI)(nil).Update), ctx, boardID, widgetID, w)
+}
+
+// UpdateMany mocks base method.
+func (m *MockWidgetsAPI) UpdateMany(ctx context.Context, boardID string, widgets []miro.Widget, opt *miro.BulkOptions) (miro.BulkResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMany", ctx, boardID, widgets, opt)
+	ret0, _ := ret[0].(miro.BulkResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMany indicates an expected call of UpdateMany.
+func (mr *MockWidgetsAPIMockRecorder) UpdateMany(ctx, boardID, widgets, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMany", reflect.TypeOf((*MockWidgetsAPI)(nil).UpdateMany), ctx, boardID, widgets, opt)
+}
+
+// MockBoardsV2API is a mock of BoardsV2API interface.
+type MockBoardsV2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardsV2APIMockRecorder
+}
+
+// MockBoardsV2APIMockRecorder is the mock recorder for MockBoardsV2API.
+type MockBoardsV2APIMockRecorder struct {
+	mock *MockBoardsV2API
+}
+
+// NewMockBoardsV2API creates a new mock instance.
+func NewMockBoardsV2API(ctrl *gomock.Controller) *MockBoardsV2API {
+	mock := &MockBoardsV2API{ctrl: ctrl}
+	mock.recorder = &MockBoardsV2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardsV2API) EXPECT() *MockBoardsV2APIMockRecorder {
+	return m.recorder
+}
+
+// Copy mocks base method.
+func (m *MockBoardsV2API) Copy(ctx context.Context, id string, body *miro.CreateBoardV2Request) (*miro.BoardV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", ctx, id, body)
+	ret0, _ := ret[0].(*miro.BoardV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockBoardsV2APIMockRecorder) Copy(ctx, id, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockBoardsV2API)(nil).Copy), ctx, id, body)
+}
+
+// Create mocks base method.
+func (m *MockBoardsV2API) Create(ctx context.Context, body *miro.CreateBoardV2Request) (*miro.BoardV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, body)
+	ret0, _ := ret[0].(*miro.BoardV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardsV2APIMockRecorder) Create(ctx, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardsV2API)(nil).Create), ctx, body)
+}
+
+// Delete mocks base method.
+func (m *MockBoardsV2API) Delete(ctx context.Context, id string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardsV2APIMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardsV2API)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockBoardsV2API) Get(ctx context.Context, id string) (*miro.BoardV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*miro.BoardV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBoardsV2APIMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoardsV2API)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockBoardsV2API) List(ctx context.Context, opt *miro.ListBoardsV2Options) (*miro.ListBoardsV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*miro.ListBoardsV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockBoardsV2APIMockRecorder) List(ctx, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBoardsV2API)(nil).List), ctx, opt)
+}
+
+// ListAll mocks base method.
+func (m *MockBoardsV2API) ListAll(ctx context.Context, opt *miro.ListBoardsV2Options) ([]*miro.BoardV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, opt)
+	ret0, _ := ret[0].([]*miro.BoardV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockBoardsV2APIMockRecorder) ListAll(ctx, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockBoardsV2API)(nil).ListAll), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockBoardsV2API) Update(ctx context.Context, id string, body *miro.CreateBoardV2Request) (*miro.BoardV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, body)
+	ret0, _ := ret[0].(*miro.BoardV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBoardsV2APIMockRecorder) Update(ctx, id, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardsV2API)(nil).Update), ctx, id, body)
+}
+
+// MockItemsV2API is a mock of ItemsV2API interface.
+type MockItemsV2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemsV2APIMockRecorder
+}
+
+// MockItemsV2APIMockRecorder is the mock recorder for MockItemsV2API.
+type MockItemsV2APIMockRecorder struct {
+	mock *MockItemsV2API
+}
+
+// NewMockItemsV2API creates a new mock instance.
+func NewMockItemsV2API(ctrl *gomock.Controller) *MockItemsV2API {
+	mock := &MockItemsV2API{ctrl: ctrl}
+	mock.recorder = &MockItemsV2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemsV2API) EXPECT() *MockItemsV2APIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockItemsV2API) Create(ctx context.Context, boardID string, itemType miro.ItemTypeV2, body *miro.ItemV2Request) (*miro.ItemV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardID, itemType, body)
+	ret0, _ := ret[0].(*miro.ItemV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockItemsV2APIMockRecorder) Create(ctx, boardID, itemType, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockItemsV2API)(nil).Create), ctx, boardID, itemType, body)
+}
+
+// Delete mocks base method.
+func (m *MockItemsV2API) Delete(ctx context.Context, boardID, itemID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, itemID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockItemsV2APIMockRecorder) Delete(ctx, boardID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockItemsV2API)(nil).Delete), ctx, boardID, itemID)
+}
+
+// Get mocks base method.
+func (m *MockItemsV2API) Get(ctx context.Context, boardID, itemID string) (*miro.ItemV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, itemID)
+	ret0, _ := ret[0].(*miro.ItemV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockItemsV2APIMockRecorder) Get(ctx, boardID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockItemsV2API)(nil).Get), ctx, boardID, itemID)
+}
+
+// List mocks base method.
+func (m *MockItemsV2API) List(ctx context.Context, boardID string, opt *miro.ListItemsV2Options) (*miro.ListItemsV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID, opt)
+	ret0, _ := ret[0].(*miro.ListItemsV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockItemsV2APIMockRecorder) List(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockItemsV2API)(nil).List), ctx, boardID, opt)
+}
+
+// ListAll mocks base method.
+func (m *MockItemsV2API) ListAll(ctx context.Context, boardID string, opt *miro.ListItemsV2Options) ([]*miro.ItemV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, boardID, opt)
+	ret0, _ := ret[0].([]*miro.ItemV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockItemsV2APIMockRecorder) ListAll(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockItemsV2API)(nil).ListAll), ctx, boardID, opt)
+}
+
+// Update mocks base method.
+func (m *MockItemsV2API) Update(ctx context.Context, boardID string, itemType miro.ItemTypeV2, itemID string, body *miro.ItemV2Request) (*miro.ItemV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, itemType, itemID, body)
+	ret0, _ := ret[0].(*miro.ItemV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockItemsV2APIMockRecorder) Update(ctx, boardID, itemType, itemID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemsV2API)(nil).Update), ctx, boardID, itemType, itemID, body)
+}
+
+// MockConnectorsV2API is a mock of ConnectorsV2API interface.
+type MockConnectorsV2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectorsV2APIMockRecorder
+}
+
+// MockConnectorsV2APIMockRecorder is the mock recorder for MockConnectorsV2API.
+type MockConnectorsV2APIMockRecorder struct {
+	mock *MockConnectorsV2API
+}
+
+// NewMockConnectorsV2API creates a new mock instance.
+func NewMockConnectorsV2API(ctrl *gomock.Controller) *MockConnectorsV2API {
+	mock := &MockConnectorsV2API{ctrl: ctrl}
+	mock.recorder = &MockConnectorsV2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnectorsV2API) EXPECT() *MockConnectorsV2APIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockConnectorsV2API) Create(ctx context.Context, boardID string, body *miro.ConnectorV2Request) (*miro.ConnectorV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardID, body)
+	ret0, _ := ret[0].(*miro.ConnectorV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockConnectorsV2APIMockRecorder) Create(ctx, boardID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConnectorsV2API)(nil).Create), ctx, boardID, body)
+}
+
+// Delete mocks base method.
+func (m *MockConnectorsV2API) Delete(ctx context.Context, boardID, connectorID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, connectorID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockConnectorsV2APIMockRecorder) Delete(ctx, boardID, connectorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockConnectorsV2API)(nil).Delete), ctx, boardID, connectorID)
+}
+
+// Get mocks base method.
+func (m *MockConnectorsV2API) Get(ctx context.Context, boardID, connectorID string) (*miro.ConnectorV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, connectorID)
+	ret0, _ := ret[0].(*miro.ConnectorV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConnectorsV2APIMockRecorder) Get(ctx, boardID, connectorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConnectorsV2API)(nil).Get), ctx, boardID, connectorID)
+}
+
+// List mocks base method.
+func (m *MockConnectorsV2API) List(ctx context.Context, boardID string, opt *miro.CursorOptions) (*miro.ListConnectorsV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID, opt)
+	ret0, _ := ret[0].(*miro.ListConnectorsV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockConnectorsV2APIMockRecorder) List(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockConnectorsV2API)(nil).List), ctx, boardID, opt)
+}
+
+// ListAll mocks base method.
+func (m *MockConnectorsV2API) ListAll(ctx context.Context, boardID string) ([]*miro.ConnectorV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.ConnectorV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockConnectorsV2APIMockRecorder) ListAll(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockConnectorsV2API)(nil).ListAll), ctx, boardID)
+}
+
+// Update mocks base method.
+func (m *MockConnectorsV2API) Update(ctx context.Context, boardID, connectorID string, body *miro.ConnectorV2Request) (*miro.ConnectorV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, connectorID, body)
+	ret0, _ := ret[0].(*miro.ConnectorV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockConnectorsV2APIMockRecorder) Update(ctx, boardID, connectorID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockConnectorsV2API)(nil).Update), ctx, boardID, connectorID, body)
+}
+
+// MockTagsV2API is a mock of TagsV2API interface.
+type MockTagsV2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsV2APIMockRecorder
+}
+
+// MockTagsV2APIMockRecorder is the mock recorder for MockTagsV2API.
+type MockTagsV2APIMockRecorder struct {
+	mock *MockTagsV2API
+}
+
+// NewMockTagsV2API creates a new mock instance.
+func NewMockTagsV2API(ctrl *gomock.Controller) *MockTagsV2API {
+	mock := &MockTagsV2API{ctrl: ctrl}
+	mock.recorder = &MockTagsV2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagsV2API) EXPECT() *MockTagsV2APIMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockTagsV2API) Attach(ctx context.Context, boardID, itemID, tagID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, boardID, itemID, tagID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockTagsV2APIMockRecorder) Attach(ctx, boardID, itemID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockTagsV2API)(nil).Attach), ctx, boardID, itemID, tagID)
+}
+
+// Create mocks base method.
+func (m *MockTagsV2API) Create(ctx context.Context, boardID string, body *miro.TagV2Request) (*miro.TagV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, boardID, body)
+	ret0, _ := ret[0].(*miro.TagV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagsV2APIMockRecorder) Create(ctx, boardID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagsV2API)(nil).Create), ctx, boardID, body)
+}
+
+// Delete mocks base method.
+func (m *MockTagsV2API) Delete(ctx context.Context, boardID, tagID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, tagID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagsV2APIMockRecorder) Delete(ctx, boardID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagsV2API)(nil).Delete), ctx, boardID, tagID)
+}
+
+// Detach mocks base method.
+func (m *MockTagsV2API) Detach(ctx context.Context, boardID, itemID, tagID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", ctx, boardID, itemID, tagID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockTagsV2APIMockRecorder) Detach(ctx, boardID, itemID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockTagsV2API)(nil).Detach), ctx, boardID, itemID, tagID)
+}
+
+// Get mocks base method.
+func (m *MockTagsV2API) Get(ctx context.Context, boardID, tagID string) (*miro.TagV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, tagID)
+	ret0, _ := ret[0].(*miro.TagV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTagsV2APIMockRecorder) Get(ctx, boardID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagsV2API)(nil).Get), ctx, boardID, tagID)
+}
+
+// List mocks base method.
+func (m *MockTagsV2API) List(ctx context.Context, boardID string, opt *miro.ListOptions) (*miro.ListTagsV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID, opt)
+	ret0, _ := ret[0].(*miro.ListTagsV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTagsV2APIMockRecorder) List(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTagsV2API)(nil).List), ctx, boardID, opt)
+}
+
+// ListAll mocks base method.
+func (m *MockTagsV2API) ListAll(ctx context.Context, boardID string) ([]*miro.TagV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.TagV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockTagsV2APIMockRecorder) ListAll(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockTagsV2API)(nil).ListAll), ctx, boardID)
+}
+
+// ListItemTags mocks base method.
+func (m *MockTagsV2API) ListItemTags(ctx context.Context, boardID, itemID string) ([]*miro.TagV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemTags", ctx, boardID, itemID)
+	ret0, _ := ret[0].([]*miro.TagV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListItemTags indicates an expected call of ListItemTags.
+func (mr *MockTagsV2APIMockRecorder) ListItemTags(ctx, boardID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemTags", reflect.TypeOf((*MockTagsV2API)(nil).ListItemTags), ctx, boardID, itemID)
+}
+
+// Update mocks base method.
+func (m *MockTagsV2API) Update(ctx context.Context, boardID, tagID string, body *miro.TagV2Request) (*miro.TagV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, tagID, body)
+	ret0, _ := ret[0].(*miro.TagV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagsV2APIMockRecorder) Update(ctx, boardID, tagID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagsV2API)(nil).Update), ctx, boardID, tagID, body)
+}
+
+// MockBoardMembersV2API is a mock of BoardMembersV2API interface.
+type MockBoardMembersV2API struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardMembersV2APIMockRecorder
+}
+
+// MockBoardMembersV2APIMockRecorder is the mock recorder for MockBoardMembersV2API.
+type MockBoardMembersV2APIMockRecorder struct {
+	mock *MockBoardMembersV2API
+}
+
+// NewMockBoardMembersV2API creates a new mock instance.
+func NewMockBoardMembersV2API(ctrl *gomock.Controller) *MockBoardMembersV2API {
+	mock := &MockBoardMembersV2API{ctrl: ctrl}
+	mock.recorder = &MockBoardMembersV2APIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardMembersV2API) EXPECT() *MockBoardMembersV2APIMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBoardMembersV2API) Delete(ctx context.Context, boardID, memberID string) (*miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, boardID, memberID)
+	ret0, _ := ret[0].(*miro.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardMembersV2APIMockRecorder) Delete(ctx, boardID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardMembersV2API)(nil).Delete), ctx, boardID, memberID)
+}
+
+// Get mocks base method.
+func (m *MockBoardMembersV2API) Get(ctx context.Context, boardID, memberID string) (*miro.BoardMemberV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, boardID, memberID)
+	ret0, _ := ret[0].(*miro.BoardMemberV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBoardMembersV2APIMockRecorder) Get(ctx, boardID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBoardMembersV2API)(nil).Get), ctx, boardID, memberID)
+}
+
+// List mocks base method.
+func (m *MockBoardMembersV2API) List(ctx context.Context, boardID string, opt *miro.ListOptions) (*miro.ListBoardMembersV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, boardID, opt)
+	ret0, _ := ret[0].(*miro.ListBoardMembersV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockBoardMembersV2APIMockRecorder) List(ctx, boardID, opt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBoardMembersV2API)(nil).List), ctx, boardID, opt)
+}
+
+// ListAll mocks base method.
+func (m *MockBoardMembersV2API) ListAll(ctx context.Context, boardID string) ([]*miro.BoardMemberV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, boardID)
+	ret0, _ := ret[0].([]*miro.BoardMemberV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockBoardMembersV2APIMockRecorder) ListAll(ctx, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockBoardMembersV2API)(nil).ListAll), ctx, boardID)
+}
+
+// Share mocks base method.
+func (m *MockBoardMembersV2API) Share(ctx context.Context, boardID string, body *miro.ShareBoardV2Request) (*miro.ShareBoardV2Response, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, boardID, body)
+	ret0, _ := ret[0].(*miro.ShareBoardV2Response)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockBoardMembersV2APIMockRecorder) Share(ctx, boardID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockBoardMembersV2API)(nil).Share), ctx, boardID, body)
+}
+
+// Update mocks base method.
+func (m *MockBoardMembersV2API) Update(ctx context.Context, boardID, memberID string, role miro.BoardRole) (*miro.BoardMemberV2, *miro.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, boardID, memberID, role)
+	ret0, _ := ret[0].(*miro.BoardMemberV2)
+	ret1, _ := ret[1].(*miro.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBoardMembersV2APIMockRecorder) Update(ctx, boardID, memberID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardMembersV2API)(nil).Update), ctx, boardID, memberID, role)
+}
@@ -0,0 +1,252 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	orgMembersPath = "members"
+)
+
+// OrganizationsService handles communication to Miro Organizations API.
+// The API v1 has no organization endpoint, the Enterprise API v2 is called.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization
+type OrganizationsService service
+
+// Organization object represents Miro Organization.
+// Audit logs only set its ID and name.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization
+//
+//go:generate gomodifytags -file $GOFILE -struct Organization -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Organization -add-tags json -w -transform camelcase
+type Organization struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	Type                  string `json:"type,omitempty"`
+	Plan                  string `json:"plan,omitempty"`
+	FullLicensesPurchased int    `json:"fullLicensesPurchased,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// OrganizationRole represents the role of a member in an organization.
+type OrganizationRole string
+
+const (
+	OrganizationRoleInternalAdmin OrganizationRole = "organization_internal_admin"
+	OrganizationRoleInternalUser  OrganizationRole = "organization_internal_user"
+	OrganizationRoleExternalUser  OrganizationRole = "organization_external_user"
+	OrganizationRoleTeamGuestUser OrganizationRole = "organization_team_guest_user"
+	OrganizationRoleUnknown       OrganizationRole = "unknown"
+)
+
+// License represents the license type of a member in an organization.
+type License string
+
+const (
+	LicenseFull           License = "full"
+	LicenseOccasional     License = "occasional"
+	LicenseFree           License = "free"
+	LicenseFreeRestricted License = "free_restricted"
+	LicenseFullTrial      License = "full_trial"
+	LicenseUnknown        License = "unknown"
+)
+
+// OrganizationMember object represents a member of Miro Organization.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization-member
+//
+//go:generate gomodifytags -file $GOFILE -struct OrganizationMember -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct OrganizationMember -add-tags json -w -transform camelcase
+type OrganizationMember struct {
+	ID               string           `json:"id"`
+	Type             string           `json:"type,omitempty"`
+	Email            string           `json:"email"`
+	Role             OrganizationRole `json:"role"`
+	License          License          `json:"license"`
+	Active           bool             `json:"active"`
+	LastActivityAt   *time.Time       `json:"lastActivityAt,omitempty"`
+	LicenseChangedAt *time.Time       `json:"licenseChangedAt,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ListOrganizationMembersOptions specifies the optional parameters to ListMembers.
+// Emails filters the members by email, the other filters are not sent when it is set.
+type ListOrganizationMembersOptions struct {
+	Emails  []string
+	Role    OrganizationRole
+	License License
+	Active  *bool
+
+	CursorOptions
+}
+
+// updateOrganizationMemberRequest represents update organization member request payload.
+type updateOrganizationMemberRequest struct {
+	Active bool `json:"active"`
+}
+
+// Get gets the organization by Organization ID.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization
+func (s *OrganizationsService) Get(ctx context.Context, id string) (*Organization, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s", orgsPath, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Organization](ctx, s.client, req, http.StatusOK)
+}
+
+// ListMembers lists the members of the organization by Organization ID.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization-members
+func (s *OrganizationsService) ListMembers(ctx context.Context, id string, opt *ListOrganizationMembersOptions) (*ListOrganizationMembersResponse, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.ListMembers")
+
+	v := url.Values{}
+	if opt != nil {
+		if len(opt.Emails) > 0 {
+			v.Set("emails", strings.Join(opt.Emails, ","))
+		} else {
+			v.Set("role", string(opt.Role))
+			v.Set("license", string(opt.License))
+			if opt.Active != nil {
+				v.Set("active", strconv.FormatBool(*opt.Active))
+			}
+		}
+		addCursorOptions(v, &opt.CursorOptions)
+	}
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", orgsPath, id, orgMembersPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListOrganizationMembersResponse](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAllMembers lists every member of the organization matching the options by following the cursor.
+func (s *OrganizationsService) ListAllMembers(ctx context.Context, id string, opt *ListOrganizationMembersOptions) ([]*OrganizationMember, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.ListAllMembers")
+
+	o := ListOrganizationMembersOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return listAllCursor(ctx, func(ctx context.Context, co *CursorOptions) (*ListOrganizationMembersResponse, *Response, error) {
+		o.CursorOptions = CursorOptions{Limit: o.Limit, Cursor: co.Cursor}
+		return s.ListMembers(ctx, id, &o)
+	})
+}
+
+// GetMember gets the member of the organization by Organization ID and Member ID.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-organization-member
+func (s *OrganizationsService) GetMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.GetMember")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", orgsPath, id, orgMembersPath, memberID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[OrganizationMember](ctx, s.client, req, http.StatusOK)
+}
+
+// DeactivateMember deactivates the member of the organization by Organization ID and Member ID,
+// releasing the license of the member while keeping the boards and content of the member.
+//
+// Experimental: the endpoint is not documented by Miro yet, the request and the response may change.
+//
+// API doc: No document yet
+func (s *OrganizationsService) DeactivateMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.DeactivateMember")
+
+	return s.setActive(ctx, id, memberID, false)
+}
+
+// ReactivateMember reactivates the deactivated member of the organization by Organization ID and Member ID.
+//
+// Experimental: the endpoint is not documented by Miro yet, the request and the response may change.
+//
+// API doc: No document yet
+func (s *OrganizationsService) ReactivateMember(ctx context.Context, id, memberID string) (*OrganizationMember, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.ReactivateMember")
+
+	return s.setActive(ctx, id, memberID, true)
+}
+
+func (s *OrganizationsService) setActive(ctx context.Context, id, memberID string, active bool) (*OrganizationMember, *Response, error) {
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s", orgsPath, id, orgMembersPath, memberID), &updateOrganizationMemberRequest{Active: active})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[OrganizationMember](ctx, s.client, req, http.StatusOK)
+}
+
+// ListTeams lists the teams of the organization by Organization ID, like TeamsService.ListByOrganization.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-teams
+func (s *OrganizationsService) ListTeams(ctx context.Context, id string, opt *CursorOptions) (*ListTeamsResponse, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.ListTeams")
+
+	return (*TeamsService)(s).ListByOrganization(ctx, id, opt)
+}
+
+// ListAllTeams lists every team of the organization by following the cursor.
+func (s *OrganizationsService) ListAllTeams(ctx context.Context, id string) ([]*Team, *Response, error) {
+	ctx = withMethod(ctx, "OrganizationsService.ListAllTeams")
+
+	return (*TeamsService)(s).ListAllByOrganization(ctx, id)
+}
+
+// UnmarshalJSON decodes the organization keeping the members unknown to this package in Extra.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	type alias Organization
+	extra, err := unmarshalExtra(data, (*alias)(o))
+	if err != nil {
+		return err
+	}
+
+	o.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the organization with the members in Extra.
+func (o Organization) MarshalJSON() ([]byte, error) {
+	type alias Organization
+	return marshalExtra(alias(o), o.Extra)
+}
+
+// UnmarshalJSON decodes the organization member keeping the members unknown to this package in Extra.
+func (m *OrganizationMember) UnmarshalJSON(data []byte) error {
+	type alias OrganizationMember
+	extra, err := unmarshalExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the organization member with the members in Extra.
+func (m OrganizationMember) MarshalJSON() ([]byte, error) {
+	type alias OrganizationMember
+	return marshalExtra(alias(m), m.Extra)
+}
@@ -0,0 +1,192 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getOrganizationMemberJSON(id string, license License) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "organization-member",
+	"email": "%s@test.com",
+	"role": "organization_internal_user",
+	"license": "%s",
+	"active": true,
+	"lastActivityAt": "1995-06-15T10:00:00Z"
+}`, id, id, license)
+}
+
+func getOrganizationMember(id string, license License) *OrganizationMember {
+	at := time.Date(1995, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	return &OrganizationMember{
+		ID:             id,
+		Type:           "organization-member",
+		Email:          id + "@test.com",
+		Role:           OrganizationRoleInternalUser,
+		License:        license,
+		Active:         true,
+		LastActivityAt: &at,
+	}
+}
+
+func TestOrganizationsService_Get(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/org", orgsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "org", "type": "organization", "name": "Miro", "plan": "enterprise", "fullLicensesPurchased": 50}`)
+	})
+
+	got, _, err := client.Organizations.Get(context.Background(), "org")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := &Organization{ID: "org", Type: "organization", Name: "Miro", Plan: "enterprise", FullLicensesPurchased: 50}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestOrganizationsService_ListAllMembers(t *testing.T) {
+	active := true
+
+	tcs := map[string]struct {
+		opt   *ListOrganizationMembersOptions
+		query string
+	}{
+		"no filter":           {nil, ""},
+		"filters":             {&ListOrganizationMembersOptions{License: LicenseFull, Active: &active}, "active=true&license=full"},
+		"emails":              {&ListOrganizationMembersOptions{Emails: []string{"a@test.com", "b@test.com"}}, "emails=a%40test.com%2Cb%40test.com"},
+		"emails with filters": {&ListOrganizationMembersOptions{Emails: []string{"a@test.com"}, Role: OrganizationRoleInternalAdmin, Active: &active}, "emails=a%40test.com"},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setupV2()
+			defer teardown()
+
+			mux.HandleFunc(fmt.Sprintf("/%s/org/%s", orgsPath, orgMembersPath), func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				cursor := q.Get("cursor")
+				q.Del("cursor")
+				if diff := cmp.Diff(q.Encode(), tc.query); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				if cursor == "" {
+					fmt.Fprintf(w, `{"type": "cursor-list", "data": [%s], "cursor": "next"}`, getOrganizationMemberJSON("1", LicenseFull))
+					return
+				}
+
+				fmt.Fprintf(w, `{"type": "cursor-list", "data": [%s]}`, getOrganizationMemberJSON("2", LicenseOccasional))
+			})
+
+			got, _, err := client.Organizations.ListAllMembers(context.Background(), "org", tc.opt)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			want := []*OrganizationMember{getOrganizationMember("1", LicenseFull), getOrganizationMember("2", LicenseOccasional)}
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationsService_GetMember(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s/1", orgsPath, orgMembersPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getOrganizationMemberJSON("1", LicenseFull))
+	})
+
+	got, _, err := client.Organizations.GetMember(context.Background(), "org", "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, getOrganizationMember("1", LicenseFull)); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestOrganizationsService_DeactivateMember(t *testing.T) {
+	tcs := map[string]struct {
+		deactivate bool
+		want       string
+	}{
+		"deactivate": {true, `{"active":false}`},
+		"reactivate": {false, `{"active":true}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setupV2()
+			defer teardown()
+
+			var got string
+			mux.HandleFunc(fmt.Sprintf("/%s/org/%s/1", orgsPath, orgMembersPath), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				var body map[string]json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				b, _ := json.Marshal(body)
+				got = string(b)
+
+				fmt.Fprintf(w, `{"id": "1", "active": %s}`, body["active"])
+			})
+
+			var m *OrganizationMember
+			var err error
+			if tc.deactivate {
+				m, _, err = client.Organizations.DeactivateMember(context.Background(), "org", "1")
+			} else {
+				m, _, err = client.Organizations.ReactivateMember(context.Background(), "org", "1")
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if m.Active == tc.deactivate {
+				t.Fatalf("active not expected, got:%v", m.Active)
+			}
+		})
+	}
+}
+
+func TestOrganizationsService_ListAllTeams(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s", orgsPath, teamsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data": [%s, %s]}`, getTeamJSON("1"), getTeamJSON("2"))
+	})
+
+	got, _, err := client.Organizations.ListAllTeams(context.Background(), "org")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, []*Team{getTeam("1"), getTeam("2")}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
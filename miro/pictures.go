@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 )
 
 const (
@@ -21,45 +20,39 @@ type PicturesService service
 // User object represents Miro User.
 //
 // API doc: https://developers.miro.com/reference#user-object
+//
 //go:generate gomodifytags -file $GOFILE -struct Picture -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Picture -add-tags json -w -transform camelcase
 type Picture struct {
 	ID       string `json:"id"`
 	ImageURL string `json:"imageURL"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // MiniPicture object represents Miro Mini picture.
+//
 //go:generate gomodifytags -file $GOFILE -struct MiniPicture -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct MiniPicture -add-tags json -w -transform camelcase
 type MiniPicture struct {
 	ID       string `json:"id"`
 	ImageURL string `json:"imageURL"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Get gets picture by Picture ID.
 //
 // API doc: https://developers.miro.com/reference#get-user
-func (s *PicturesService) Get(ctx context.Context, id string) (*Picture, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("type/%s/%s", id, picturesPath))
-	if err != nil {
-		return nil, err
-	}
+func (s *PicturesService) Get(ctx context.Context, id string) (*Picture, *Response, error) {
+	ctx = withMethod(ctx, "PicturesService.Get")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("type/%s/%s", id, picturesPath))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	picture := &Picture{}
-	if err := json.NewDecoder(resp.Body).Decode(picture); err != nil {
-		return nil, err
-	}
-
-	return picture, nil
+	return do[Picture](ctx, s.client, req, http.StatusOK)
 }
 
 // UpsertPictureRequest represents update board request payload.
@@ -73,69 +66,67 @@ type UpsertPictureRequest struct {
 // Upsert upserts a picture by Picture ID.
 //
 // API doc: https://developers.miro.com/reference#create-or-update-picture
-func (s *PicturesService) Upsert(ctx context.Context, id string, request *UpsertPictureRequest) (*Picture, error) {
+func (s *PicturesService) Upsert(ctx context.Context, id string, request *UpsertPictureRequest) (*Picture, *Response, error) {
+	ctx = withMethod(ctx, "PicturesService.Upsert")
+
 	req, err := s.client.NewPostRequest(fmt.Sprintf("type/%s/%s", id, picturesPath), request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req.Header.Del("Content-Type")
 	req.Header.Set("content-type", "multipart/form-data")
 
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	picture := &Picture{}
-	if err := json.NewDecoder(resp.Body).Decode(picture); err != nil {
-		return nil, err
-	}
-
-	return picture, nil
+	return do[Picture](ctx, s.client, req, http.StatusOK)
 }
 
 // Delete deletes picture by Picture ID.
 //
 // API doc: https://developers.miro.com/reference#delete-picture
-func (s *PicturesService) Delete(ctx context.Context, id string) error {
+func (s *PicturesService) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withMethod(ctx, "PicturesService.Delete")
+
 	req, err := s.client.NewDeleteRequest(fmt.Sprintf("type/%s/%s", id, picturesPath))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// UnmarshalJSON decodes the picture keeping the members unknown to this package in Extra.
+func (p *Picture) UnmarshalJSON(data []byte) error {
+	type alias Picture
+	extra, err := unmarshalExtra(data, (*alias)(p))
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
+	p.Extra = extra
 	return nil
 }
 
-func (p *Picture) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
+// MarshalJSON encodes the picture with the members in Extra.
+func (p Picture) MarshalJSON() ([]byte, error) {
+	type alias Picture
+	return marshalExtra(alias(p), p.Extra)
+}
 
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the mini picture keeping the members unknown to this package in Extra.
+func (p *MiniPicture) UnmarshalJSON(data []byte) error {
+	type alias MiniPicture
+	extra, err := unmarshalExtra(data, (*alias)(p))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			p.ID = v.(string)
-		}
-
-		if strings.ToLower(k) == "imageurl" {
-			p.ImageURL = v.(string)
-		}
-	}
-
+	p.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the mini picture with the members in Extra.
+func (p MiniPicture) MarshalJSON() ([]byte, error) {
+	type alias MiniPicture
+	return marshalExtra(alias(p), p.Extra)
+}
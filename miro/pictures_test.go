@@ -45,7 +45,7 @@ func TestPicturesService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getPictureJSON(tc.id)))
 			})
 
-			got, err := client.Picture.Get(context.Background(), tc.id)
+			got, _, err := client.Picture.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -75,7 +75,7 @@ func TestPicturesService_Upsert(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getPictureJSON(tc.id)))
 			})
 
-			got, err := client.Picture.Upsert(context.Background(), tc.id, &UpsertPictureRequest{
+			got, _, err := client.Picture.Upsert(context.Background(), tc.id, &UpsertPictureRequest{
 				tc.Image,
 			})
 			if err != nil {
@@ -107,7 +107,7 @@ func TestPicturesService_Delete(t *testing.T) {
 				w.Write([]byte("{}"))
 			})
 
-			err := client.Picture.Delete(context.Background(), tc.id)
+			_, err := client.Picture.Delete(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -0,0 +1,370 @@
+// Package policy enforces sharing policies on Miro boards.
+//
+// An Enforcer checks boards either periodically with Run or on demand from a
+// webhook with WebhookHandler, reverts boards that violate the policy and
+// records every action it takes in a local log.
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const (
+	defaultInterval = 5 * time.Minute
+)
+
+// Policy represents the sharing policy to enforce on boards.
+type Policy struct {
+	// AllowedAccess is the list of public link access levels boards may have.
+	// Empty means every access level is allowed.
+	AllowedAccess []miro.AccessLevel
+
+	// FallbackAccess is the public link access level to revert to.
+	FallbackAccess miro.AccessLevel
+
+	// AllowedTeamAccess is the list of team access levels boards may have.
+	// Empty means every access level is allowed.
+	AllowedTeamAccess []miro.AccessLevel
+
+	// FallbackTeamAccess is the team access level to revert to.
+	FallbackTeamAccess miro.AccessLevel
+
+	// AllowedDomains is the list of email domains boards may be shared with.
+	// Empty means boards may be shared with anyone.
+	AllowedDomains []string
+}
+
+// ActionType represents the kind of action taken by the enforcer.
+type ActionType string
+
+const (
+	ActionRevertAccess     ActionType = "revert-access"
+	ActionRevertTeamAccess ActionType = "revert-team-access"
+	ActionRemoveMember     ActionType = "remove-member"
+	ActionCheckFailed      ActionType = "check-failed"
+)
+
+// Action represents an action taken by the enforcer on a board.
+type Action struct {
+	Time    time.Time  `json:"time"`
+	Type    ActionType `json:"type"`
+	TeamID  string     `json:"teamId,omitempty"`
+	BoardID string     `json:"boardId,omitempty"`
+	From    string     `json:"from,omitempty"`
+	To      string     `json:"to,omitempty"`
+	UserID  string     `json:"userId,omitempty"`
+	Email   string     `json:"email,omitempty"`
+	DryRun  bool       `json:"dryRun,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+
+// Enforcer enforces a sharing policy on boards.
+type Enforcer struct {
+	client *miro.Client
+	policy *Policy
+
+	// TeamIDs is the list of teams whose boards are checked by Run.
+	TeamIDs []string
+
+	// Interval is the polling interval of Run.
+	Interval time.Duration
+
+	// DryRun records the actions without changing the boards.
+	DryRun bool
+
+	logMu sync.Mutex
+	log   io.Writer
+
+	usersMu sync.Mutex
+	users   map[string]*miro.User
+
+	// pending are the boards enforced in the background for webhook events, true when an event
+	// arrived during the enforcement. background waits for their goroutines.
+	pendingMu  sync.Mutex
+	pending    map[string]bool
+	background sync.WaitGroup
+}
+
+// NewEnforcer returns a new enforcer recording its actions to log as JSON lines.
+// The fallback access levels of the policy must be among the allowed ones when these are restricted.
+func NewEnforcer(client *miro.Client, policy *Policy, log io.Writer) (*Enforcer, error) {
+	if err := checkFallback("access", policy.AllowedAccess, policy.FallbackAccess); err != nil {
+		return nil, err
+	}
+	if err := checkFallback("team access", policy.AllowedTeamAccess, policy.FallbackTeamAccess); err != nil {
+		return nil, err
+	}
+
+	return &Enforcer{
+		client:   client,
+		policy:   policy,
+		Interval: defaultInterval,
+		log:      log,
+		users:    map[string]*miro.User{},
+		pending:  map[string]bool{},
+	}, nil
+}
+
+// checkFallback checks that the boards violating the allowed access levels are reverted to an allowed one.
+func checkFallback(name string, allowed []miro.AccessLevel, fallback miro.AccessLevel) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	if fallback == "" {
+		return fmt.Errorf("fallback %s is empty", name)
+	}
+
+	if !isAllowedAccess(allowed, fallback) {
+		return fmt.Errorf("fallback %s is not allowed, got:%s", name, fallback)
+	}
+
+	return nil
+}
+
+// Run checks every board of the teams until the context is canceled.
+func (e *Enforcer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		for _, teamID := range e.TeamIDs {
+			if _, err := e.EnforceTeam(ctx, teamID); err != nil && ctx.Err() == nil {
+				e.record(withError(&Action{Type: ActionCheckFailed, TeamID: teamID}, err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// EnforceTeam enforces the policy on every board of the team.
+// A board failing is recorded as a check-failed action and the other boards are still enforced.
+func (e *Enforcer) EnforceTeam(ctx context.Context, teamID string) ([]*Action, error) {
+	boards, _, err := e.client.Boards.ListAllTeamBoards(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := []*Action{}
+	for _, b := range boards {
+		a, err := e.enforce(ctx, b)
+		actions = append(actions, a...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return actions, ctx.Err()
+			}
+
+			failed := withError(&Action{Type: ActionCheckFailed, TeamID: teamID, BoardID: b.ID}, err)
+			e.record(failed)
+			actions = append(actions, failed)
+		}
+	}
+
+	return actions, nil
+}
+
+// Enforce enforces the policy on the board by Board ID.
+func (e *Enforcer) Enforce(ctx context.Context, boardID string) ([]*Action, error) {
+	b, _, err := e.client.Boards.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.enforce(ctx, b)
+}
+
+func (e *Enforcer) enforce(ctx context.Context, b *miro.Board) ([]*Action, error) {
+	actions := []*Action{}
+
+	if a := e.checkSharingPolicy(b); len(a) > 0 {
+		var err error
+		if !e.DryRun {
+			_, _, err = e.client.Boards.Update(ctx, b.ID, &miro.UpdateBoardRequest{
+				Name:          b.Name,
+				Description:   b.Description,
+				SharingPolicy: b.SharingPolicy,
+			})
+		}
+
+		for _, action := range a {
+			e.record(withError(action, err))
+		}
+		actions = append(actions, a...)
+
+		if err != nil {
+			return actions, err
+		}
+	}
+
+	if len(e.policy.AllowedDomains) == 0 {
+		return actions, nil
+	}
+
+	conns, _, err := e.client.BoardUserConnection.ListAllMembers(ctx, b.ID)
+	if err != nil {
+		return actions, err
+	}
+
+	for _, conn := range conns {
+		if conn.User == nil {
+			continue
+		}
+
+		user, err := e.user(ctx, conn.User.ID)
+		if err != nil {
+			return actions, err
+		}
+
+		if e.isAllowedEmail(user.Email) {
+			continue
+		}
+
+		action := &Action{
+			Type:    ActionRemoveMember,
+			BoardID: b.ID,
+			From:    string(conn.Role),
+			UserID:  user.ID,
+			Email:   user.Email,
+			DryRun:  e.DryRun,
+		}
+		actions = append(actions, action)
+
+		if conn.Role == miro.BoardRoleOwner {
+			e.record(withError(action, fmt.Errorf("owner cannot be removed")))
+			continue
+		}
+
+		if !e.DryRun {
+			_, err = e.client.BoardUserConnection.Delete(ctx, conn.ID)
+		}
+
+		e.record(withError(action, err))
+		if err != nil {
+			return actions, err
+		}
+	}
+
+	return actions, nil
+}
+
+// checkSharingPolicy reverts the sharing policy of the board in place and returns the actions to take.
+func (e *Enforcer) checkSharingPolicy(b *miro.Board) []*Action {
+	if b.SharingPolicy == nil {
+		return nil
+	}
+
+	actions := []*Action{}
+	p := b.SharingPolicy
+
+	if !isAllowedAccess(e.policy.AllowedAccess, p.Access) {
+		actions = append(actions, &Action{
+			Type:    ActionRevertAccess,
+			BoardID: b.ID,
+			From:    string(p.Access),
+			To:      string(e.policy.FallbackAccess),
+			DryRun:  e.DryRun,
+		})
+		p.Access = e.policy.FallbackAccess
+	}
+
+	if !isAllowedAccess(e.policy.AllowedTeamAccess, p.TeamAccess) {
+		actions = append(actions, &Action{
+			Type:    ActionRevertTeamAccess,
+			BoardID: b.ID,
+			From:    string(p.TeamAccess),
+			To:      string(e.policy.FallbackTeamAccess),
+			DryRun:  e.DryRun,
+		})
+		p.TeamAccess = e.policy.FallbackTeamAccess
+	}
+
+	return actions
+}
+
+func (e *Enforcer) user(ctx context.Context, id string) (*miro.User, error) {
+	e.usersMu.Lock()
+	user, ok := e.users[id]
+	e.usersMu.Unlock()
+	if ok {
+		return user, nil
+	}
+
+	user, _, err := e.client.Users.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.usersMu.Lock()
+	e.users[id] = user
+	e.usersMu.Unlock()
+
+	return user, nil
+}
+
+func (e *Enforcer) isAllowedEmail(email string) bool {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(email[i+1:])
+	for _, d := range e.policy.AllowedDomains {
+		if domain == strings.ToLower(d) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// record writes the action to the log.
+func (e *Enforcer) record(a *Action) {
+	if e.log == nil {
+		return
+	}
+
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+
+	e.logMu.Lock()
+	defer e.logMu.Unlock()
+
+	// The log is best effort, a failing log must not stop the enforcement.
+	_ = json.NewEncoder(e.log).Encode(a)
+}
+
+func withError(a *Action, err error) *Action {
+	if err != nil {
+		a.Error = err.Error()
+	}
+
+	return a
+}
+
+func isAllowedAccess(allowed []miro.AccessLevel, level miro.AccessLevel) bool {
+	if len(allowed) == 0 || level == "" {
+		return true
+	}
+
+	for _, l := range allowed {
+		if l == level {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,224 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// boardServer serves a single board with an internal owner and an external editor and records the changes.
+type boardServer struct {
+	updated *miro.UpdateBoardRequest
+	deleted []string
+}
+
+func (s *boardServer) handle(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/boards/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			s.updated = &miro.UpdateBoardRequest{}
+			if err := json.NewDecoder(r.Body).Decode(s.updated); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		}
+
+		fmt.Fprint(w, `{"id": "1", "name": "board", "description": "desc", "sharingPolicy": {"access": "edit", "teamAccess": "edit"}}`)
+	})
+
+	mux.HandleFunc("/boards/1/user-connections", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"size": 2,
+	"data": [
+		{"id": "c1", "role": "owner", "user": {"id": "alice"}},
+		{"id": "c2", "role": "editor", "user": {"id": "bob"}}
+	]
+}`)
+	})
+
+	mux.HandleFunc("/board-user-connection/", func(w http.ResponseWriter, r *http.Request) {
+		s.deleted = append(s.deleted, strings.TrimPrefix(r.URL.Path, "/board-user-connection/"))
+		fmt.Fprint(w, "{}")
+	})
+
+	mux.HandleFunc("/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "alice", "email": "alice@example.com"}`)
+	})
+
+	mux.HandleFunc("/users/bob", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "bob", "email": "bob@partner.com"}`)
+	})
+}
+
+func TestEnforcer_Enforce(t *testing.T) {
+	tcs := map[string]struct {
+		policy      *Policy
+		dryRun      bool
+		wantActions []*Action
+		wantUpdate  *miro.UpdateBoardRequest
+		wantDeleted []string
+	}{
+		"revert": {&Policy{
+			AllowedAccess:      []miro.AccessLevel{miro.AccessLevelPrivate, miro.AccessLevelView},
+			FallbackAccess:     miro.AccessLevelView,
+			AllowedDomains:     []string{"example.com"},
+			FallbackTeamAccess: miro.AccessLevelView,
+		}, false, []*Action{
+			{Type: ActionRevertAccess, BoardID: "1", From: "edit", To: "view"},
+			{Type: ActionRemoveMember, BoardID: "1", From: "editor", UserID: "bob", Email: "bob@partner.com"},
+		}, &miro.UpdateBoardRequest{
+			Name:        "board",
+			Description: "desc",
+			SharingPolicy: &miro.SharingPolicy{
+				Access:     miro.AccessLevelView,
+				TeamAccess: miro.AccessLevelEdit,
+			},
+		}, []string{"c2"}},
+		"dry run": {&Policy{
+			AllowedAccess:  []miro.AccessLevel{miro.AccessLevelPrivate},
+			FallbackAccess: miro.AccessLevelPrivate,
+			AllowedDomains: []string{"example.com"},
+		}, true, []*Action{
+			{Type: ActionRevertAccess, BoardID: "1", From: "edit", To: "private", DryRun: true},
+			{Type: ActionRemoveMember, BoardID: "1", From: "editor", UserID: "bob", Email: "bob@partner.com", DryRun: true},
+		}, nil, nil},
+		"compliant": {&Policy{}, false, []*Action{}, nil, nil},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, teardown := mirotest.Setup()
+			defer teardown()
+
+			s := &boardServer{}
+			s.handle(t, mux)
+
+			log := &bytes.Buffer{}
+			e, err := NewEnforcer(client, tc.policy, log)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+			e.DryRun = tc.dryRun
+
+			got, err := e.Enforce(context.Background(), "1")
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			opt := cmpopts.IgnoreFields(Action{}, "Time")
+			if diff := cmp.Diff(got, tc.wantActions, opt); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(s.updated, tc.wantUpdate); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(s.deleted, tc.wantDeleted); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			logged := []*Action{}
+			dec := json.NewDecoder(log)
+			for dec.More() {
+				a := &Action{}
+				if err := dec.Decode(a); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				logged = append(logged, a)
+			}
+
+			if diff := cmp.Diff(logged, tc.wantActions, opt); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestEnforcer_EnforceTeam(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	s := &boardServer{}
+	s.handle(t, mux)
+
+	// The members of board 2 are not served, so that checking it fails.
+	mux.HandleFunc("/teams/team/boards", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+	"size": 2,
+	"data": [
+		{"id": "2", "name": "locked", "sharingPolicy": {"access": "private"}},
+		{"id": "1", "name": "board", "sharingPolicy": {"access": "edit", "teamAccess": "edit"}}
+	]
+}`)
+	})
+
+	log := &bytes.Buffer{}
+	e, err := NewEnforcer(client, &Policy{AllowedDomains: []string{"example.com"}}, log)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := e.EnforceTeam(context.Background(), "team")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []*Action{
+		{Type: ActionCheckFailed, TeamID: "team", BoardID: "2", Error: "status code not expected, got:404, message:404 page not found"},
+		{Type: ActionRemoveMember, BoardID: "1", From: "editor", UserID: "bob", Email: "bob@partner.com"},
+	}
+
+	opt := cmpopts.IgnoreFields(Action{}, "Time")
+	if diff := cmp.Diff(got, want, opt); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(s.deleted, []string{"c2"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if n := strings.Count(log.String(), "\n"); n != len(want) {
+		t.Fatalf("Actions not logged, got:%d", n)
+	}
+}
+
+func TestNewEnforcer(t *testing.T) {
+	tcs := map[string]struct {
+		policy  *Policy
+		wantErr bool
+	}{
+		"ok": {&Policy{
+			AllowedAccess:      []miro.AccessLevel{miro.AccessLevelPrivate, miro.AccessLevelView},
+			FallbackAccess:     miro.AccessLevelPrivate,
+			AllowedTeamAccess:  []miro.AccessLevel{miro.AccessLevelView},
+			FallbackTeamAccess: miro.AccessLevelView,
+		}, false},
+		"unrestricted":   {&Policy{}, false},
+		"empty fallback": {&Policy{AllowedAccess: []miro.AccessLevel{miro.AccessLevelPrivate}}, true},
+		"disallowed fallback": {&Policy{
+			AllowedAccess:  []miro.AccessLevel{miro.AccessLevelPrivate},
+			FallbackAccess: miro.AccessLevelEdit,
+		}, true},
+		"empty team fallback": {&Policy{AllowedTeamAccess: []miro.AccessLevel{miro.AccessLevelView}}, true},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			_, err := NewEnforcer(miro.NewClient("miro-test"), tc.policy, nil)
+			if tc.wantErr && err == nil {
+				t.Fatalf("Should failed")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+const (
+	// signatureHeader is the header of the hex encoded HMAC-SHA256 of the request body keyed by the webhook secret.
+	signatureHeader = "X-Miro-Signature"
+
+	// maxWebhookBody is the size limit of the webhook request bodies.
+	maxWebhookBody = 1 << 20
+)
+
+// webhookPayload represents the payload of a Miro webhook request.
+type webhookPayload struct {
+	Challenge string `json:"challenge"`
+	BoardID   string `json:"boardId"`
+	Event     *struct {
+		BoardID string `json:"boardId"`
+	} `json:"event"`
+}
+
+// WebhookHandler returns a handler for Miro webhooks that enforces the policy on the board of each event.
+// The requests must be signed with secret, the secret of the webhook, the unsigned ones being rejected.
+// The challenge sent by Miro when the webhook is registered is answered as is.
+//
+// The events are answered before the enforcement, which runs in the background and records its failures
+// to the log as check-failed actions. The events of a board being enforced make it enforced once more.
+func (e *Enforcer) WebhookHandler(secret string) (http.Handler, error) {
+	if secret == "" {
+		return nil, errors.New("webhook secret is empty")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if !validSignature(secret, body, r.Header.Get(signatureHeader)) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		payload := &webhookPayload{}
+		if err := json.Unmarshal(body, payload); err != nil {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		if payload.Challenge != "" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"challenge": payload.Challenge})
+			return
+		}
+
+		boardID := payload.BoardID
+		if boardID == "" && payload.Event != nil {
+			boardID = payload.Event.BoardID
+		}
+
+		if boardID == "" {
+			http.Error(w, "board ID not found in payload", http.StatusBadRequest)
+			return
+		}
+
+		e.enforceInBackground(boardID)
+		w.WriteHeader(http.StatusAccepted)
+	}), nil
+}
+
+// validSignature reports whether signature is the HMAC-SHA256 of body keyed by secret.
+func validSignature(secret string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(got) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// enforceInBackground enforces the policy on the board in a new goroutine, unless the board is already
+// being enforced, in which case it is enforced once more when done.
+func (e *Enforcer) enforceInBackground(boardID string) {
+	e.pendingMu.Lock()
+	defer e.pendingMu.Unlock()
+
+	if _, ok := e.pending[boardID]; ok {
+		e.pending[boardID] = true
+		return
+	}
+	e.pending[boardID] = false
+
+	e.background.Add(1)
+	go func() {
+		defer e.background.Done()
+
+		for {
+			// The enforcement outlives the webhook request, it is not canceled with it.
+			if _, err := e.Enforce(context.Background(), boardID); err != nil {
+				e.record(withError(&Action{Type: ActionCheckFailed, BoardID: boardID}, err))
+			}
+
+			e.pendingMu.Lock()
+			again := e.pending[boardID]
+			if !again {
+				delete(e.pending, boardID)
+			} else {
+				e.pending[boardID] = false
+			}
+			e.pendingMu.Unlock()
+
+			if !again {
+				return
+			}
+		}
+	}()
+}
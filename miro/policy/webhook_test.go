@@ -0,0 +1,108 @@
+package policy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSecret = "secret"
+
+func sign(body string) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestEnforcer_WebhookHandler(t *testing.T) {
+	tcs := map[string]struct {
+		body       string
+		signature  string
+		wantStatus int
+		wantBody   string
+		wantUpdate bool
+		wantLog    string
+	}{
+		"challenge":     {`{"challenge": "abc"}`, "", http.StatusOK, `{"challenge":"abc"}` + "\n", false, ""},
+		"board event":   {`{"event": {"boardId": "1"}}`, "", http.StatusAccepted, "", true, `"type":"revert-access"`},
+		"no board":      {`{"event": {}}`, "", http.StatusBadRequest, "board ID not found in payload\n", false, ""},
+		"invalid":       {`{"event"`, "", http.StatusBadRequest, "invalid payload\n", false, ""},
+		"unknown":       {`{"boardId": "2"}`, "", http.StatusAccepted, "", false, `"type":"check-failed","boardId":"2"`},
+		"unsigned":      {`{"boardId": "1"}`, "-", http.StatusUnauthorized, "", false, ""},
+		"bad signature": {`{"boardId": "1"}`, sign(`{"boardId": "2"}`), http.StatusUnauthorized, "", false, ""},
+		"too large":     {`{"boardId": "1", "pad": "` + strings.Repeat("a", maxWebhookBody) + `"}`, "", http.StatusRequestEntityTooLarge, "", false, ""},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, teardown := mirotest.Setup()
+			defer teardown()
+
+			s := &boardServer{}
+			s.handle(t, mux)
+
+			log := &bytes.Buffer{}
+			e, err := NewEnforcer(client, &Policy{
+				AllowedAccess:  []miro.AccessLevel{miro.AccessLevelPrivate},
+				FallbackAccess: miro.AccessLevelPrivate,
+			}, log)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			h, err := e.WebhookHandler(testSecret)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			switch tc.signature {
+			case "":
+				r.Header.Set(signatureHeader, sign(tc.body))
+			case "-":
+			default:
+				r.Header.Set(signatureHeader, tc.signature)
+			}
+
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			e.background.Wait()
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("status code not expected, got:%d", w.Code)
+			}
+
+			if tc.wantBody != "" {
+				if diff := cmp.Diff(w.Body.String(), tc.wantBody); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+			}
+
+			if got := s.updated != nil; got != tc.wantUpdate {
+				t.Fatalf("update not expected, got:%t", got)
+			}
+
+			if !strings.Contains(log.String(), tc.wantLog) || (tc.wantLog == "" && log.Len() > 0) {
+				t.Fatalf("log not expected, got:%s", log.String())
+			}
+		})
+	}
+
+	e, err := NewEnforcer(nil, &Policy{}, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if _, err := e.WebhookHandler(""); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
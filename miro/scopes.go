@@ -0,0 +1,351 @@
+package miro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Scope represents a permission granted to an access token.
+//
+// API doc: https://developers.miro.com/reference#scopes
+type Scope string
+
+const (
+	ScopeBoardsRead              Scope = "boards:read"
+	ScopeBoardsWrite             Scope = "boards:write"
+	ScopeIdentityRead            Scope = "identity:read"
+	ScopeIdentityWrite           Scope = "identity:write"
+	ScopeTeamRead                Scope = "team:read"
+	ScopeTeamWrite               Scope = "team:write"
+	ScopeAuditLogsRead           Scope = "auditlogs:read"
+	ScopeOrganizationsRead       Scope = "organizations:read"
+	ScopeOrganizationsTeamsRead  Scope = "organizations:teams:read"
+	ScopeOrganizationsTeamsWrite Scope = "organizations:teams:write"
+)
+
+// ErrMissingScope is matched by the errors of the calls the access token lacks a scope for.
+var ErrMissingScope = errors.New("missing scope")
+
+// MissingScopeError represents a call the access token lacks scopes for, returned before sending any request.
+type MissingScopeError struct {
+	// Method is the service method called, like BoardsService.Get.
+	Method string
+
+	// Missing are the scopes the method requires that the access token is not granted.
+	Missing []Scope
+
+	// Granted are the scopes of the access token.
+	Granted []Scope
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf("missing scope, method:%s, missing:%s, granted:%s", e.Method, joinScopes(e.Missing), joinScopes(e.Granted))
+}
+
+// Is reports whether target is ErrMissingScope.
+func (e *MissingScopeError) Is(target error) bool {
+	return target == ErrMissingScope
+}
+
+// methodScopes maps the service methods, named by their service type and method like BoardsService.Get,
+// to the scopes they require. Methods reading then writing require both scopes.
+// Methods calling endpoints documented to require no scope require none. Methods calling undocumented
+// endpoints are left out, their scopes being unknown, and are not checked by FailOnMissingScope.
+var methodScopes = map[string][]Scope{
+	"AuditLogsService.Get": {ScopeAuditLogsRead},
+
+	"AuthzInfoService.Get": nil,
+
+	"BoardsService.Get":                  {ScopeBoardsRead},
+	"BoardsService.Create":               {ScopeBoardsWrite},
+	"BoardsService.Share":                {ScopeBoardsWrite},
+	"BoardsService.ShareWithRoles":       {ScopeBoardsWrite},
+	"BoardsService.Update":               {ScopeBoardsWrite},
+	"BoardsService.Delete":               {ScopeBoardsWrite},
+	"BoardsService.GetCurrentUserBoards": {ScopeBoardsRead},
+	"BoardsService.ListTeamBoards":       {ScopeBoardsRead},
+	"BoardsService.ListAllTeamBoards":    {ScopeBoardsRead},
+
+	"BoardUserConnectionService.Get":               {ScopeBoardsRead},
+	"BoardUserConnectionService.ListMembers":       {ScopeBoardsRead},
+	"BoardUserConnectionService.ListAllMembers":    {ScopeBoardsRead},
+	"BoardUserConnectionService.TransferOwnership": {ScopeBoardsRead, ScopeBoardsWrite},
+	"BoardUserConnectionService.Updates":           {ScopeBoardsWrite},
+	"BoardUserConnectionService.Delete":            {ScopeBoardsWrite},
+
+	"CommentsService.List":         {ScopeBoardsRead},
+	"CommentsService.ListByWidget": {ScopeBoardsRead},
+	"CommentsService.Get":          {ScopeBoardsRead},
+	"CommentsService.Reply":        {ScopeBoardsWrite},
+	"CommentsService.Resolve":      {ScopeBoardsWrite},
+	"CommentsService.Reopen":       {ScopeBoardsWrite},
+
+	"OrganizationsService.Get":            {ScopeOrganizationsRead},
+	"OrganizationsService.ListMembers":    {ScopeOrganizationsRead},
+	"OrganizationsService.ListAllMembers": {ScopeOrganizationsRead},
+	"OrganizationsService.GetMember":      {ScopeOrganizationsRead},
+	"OrganizationsService.ListTeams":      {ScopeOrganizationsTeamsRead},
+	"OrganizationsService.ListAllTeams":   {ScopeOrganizationsTeamsRead},
+
+	"PicturesService.Get":    {ScopeBoardsRead},
+	"PicturesService.Upsert": {ScopeBoardsWrite},
+	"PicturesService.Delete": {ScopeBoardsWrite},
+
+	"TagsService.List":   {ScopeBoardsRead},
+	"TagsService.Get":    {ScopeBoardsRead},
+	"TagsService.Create": {ScopeBoardsWrite},
+	"TagsService.Update": {ScopeBoardsWrite},
+	"TagsService.Delete": {ScopeBoardsWrite},
+	"TagsService.Attach": {ScopeBoardsRead, ScopeBoardsWrite},
+	"TagsService.Detach": {ScopeBoardsRead, ScopeBoardsWrite},
+
+	"TeamsService.Get":                      {ScopeTeamRead},
+	"TeamsService.Update":                   {ScopeTeamWrite},
+	"TeamsService.ListTeamMembers":          {ScopeTeamRead},
+	"TeamsService.ListAllTeamMembers":       {ScopeTeamRead},
+	"TeamsService.GetCurrentUserConnection": {ScopeTeamRead},
+	"TeamsService.Invite":                   {ScopeTeamWrite},
+	"TeamsService.InviteMany":               {ScopeTeamWrite},
+	"TeamsService.InviteWithRoles":          {ScopeTeamWrite},
+	"TeamsService.ListByOrganization":       {ScopeOrganizationsTeamsRead},
+	"TeamsService.ListAllByOrganization":    {ScopeOrganizationsTeamsRead},
+	"TeamsService.Create":                   {ScopeOrganizationsTeamsWrite},
+	"TeamsService.Delete":                   {ScopeOrganizationsTeamsWrite},
+	"TeamsService.GetSettings":              {ScopeOrganizationsTeamsRead},
+	"TeamsService.UpdateSettings":           {ScopeOrganizationsTeamsWrite},
+
+	"TeamUserConnectionService.Get":    {ScopeTeamRead},
+	"TeamUserConnectionService.Update": {ScopeTeamWrite},
+	"TeamUserConnectionService.Delete": {ScopeTeamWrite},
+
+	"UsersService.Get":               {ScopeIdentityRead},
+	"UsersService.GetCurrentUser":    {ScopeIdentityRead},
+	"UsersService.UpdateCurrentUser": {ScopeIdentityWrite},
+
+	"WidgetsService.List":              {ScopeBoardsRead},
+	"WidgetsService.ListByTag":         {ScopeBoardsRead},
+	"WidgetsService.Get":               {ScopeBoardsRead},
+	"WidgetsService.Create":            {ScopeBoardsWrite},
+	"WidgetsService.Update":            {ScopeBoardsWrite},
+	"WidgetsService.Move":              {ScopeBoardsWrite},
+	"WidgetsService.MoveMany":          {ScopeBoardsWrite},
+	"WidgetsService.CreateMany":        {ScopeBoardsWrite},
+	"WidgetsService.UpdateMany":        {ScopeBoardsWrite},
+	"WidgetsService.DeleteMany":        {ScopeBoardsWrite},
+	"WidgetsService.Delete":            {ScopeBoardsWrite},
+	"WidgetsService.GetFrame":          {ScopeBoardsRead},
+	"WidgetsService.ListFrameChildren": {ScopeBoardsRead},
+	"WidgetsService.MoveIntoFrame":     {ScopeBoardsRead, ScopeBoardsWrite},
+	"WidgetsService.MoveOutOfFrame":    {ScopeBoardsRead, ScopeBoardsWrite},
+	"WidgetsService.PlaceInFrame":      {ScopeBoardsRead, ScopeBoardsWrite},
+	"WidgetsService.FrameTree":         {ScopeBoardsRead},
+	"WidgetsService.Connect":           {ScopeBoardsWrite},
+	"WidgetsService.LinesAttachedTo":   {ScopeBoardsRead},
+
+	"BoardsV2Service.List":    {ScopeBoardsRead},
+	"BoardsV2Service.ListAll": {ScopeBoardsRead},
+	"BoardsV2Service.Get":     {ScopeBoardsRead},
+	"BoardsV2Service.Create":  {ScopeBoardsWrite},
+	"BoardsV2Service.Copy":    {ScopeBoardsWrite},
+	"BoardsV2Service.Update":  {ScopeBoardsWrite},
+	"BoardsV2Service.Delete":  {ScopeBoardsWrite},
+
+	"ItemsV2Service.List":    {ScopeBoardsRead},
+	"ItemsV2Service.ListAll": {ScopeBoardsRead},
+	"ItemsV2Service.Get":     {ScopeBoardsRead},
+	"ItemsV2Service.Create":  {ScopeBoardsWrite},
+	"ItemsV2Service.Update":  {ScopeBoardsWrite},
+	"ItemsV2Service.Delete":  {ScopeBoardsWrite},
+
+	"ConnectorsV2Service.List":    {ScopeBoardsRead},
+	"ConnectorsV2Service.ListAll": {ScopeBoardsRead},
+	"ConnectorsV2Service.Get":     {ScopeBoardsRead},
+	"ConnectorsV2Service.Create":  {ScopeBoardsWrite},
+	"ConnectorsV2Service.Update":  {ScopeBoardsWrite},
+	"ConnectorsV2Service.Delete":  {ScopeBoardsWrite},
+
+	"TagsV2Service.List":         {ScopeBoardsRead},
+	"TagsV2Service.ListAll":      {ScopeBoardsRead},
+	"TagsV2Service.Get":          {ScopeBoardsRead},
+	"TagsV2Service.Create":       {ScopeBoardsWrite},
+	"TagsV2Service.Update":       {ScopeBoardsWrite},
+	"TagsV2Service.Delete":       {ScopeBoardsWrite},
+	"TagsV2Service.Attach":       {ScopeBoardsWrite},
+	"TagsV2Service.Detach":       {ScopeBoardsWrite},
+	"TagsV2Service.ListItemTags": {ScopeBoardsRead},
+
+	"BoardMembersV2Service.List":    {ScopeBoardsRead},
+	"BoardMembersV2Service.ListAll": {ScopeBoardsRead},
+	"BoardMembersV2Service.Get":     {ScopeBoardsRead},
+	"BoardMembersV2Service.Share":   {ScopeBoardsWrite},
+	"BoardMembersV2Service.Update":  {ScopeBoardsWrite},
+	"BoardMembersV2Service.Delete":  {ScopeBoardsWrite},
+}
+
+// RequiredScopes returns the scopes the service method requires, the method being named by its service type
+// and method like BoardsService.Get. The second value reports whether the method is known.
+func RequiredScopes(method string) ([]Scope, bool) {
+	scopes, ok := methodScopes[method]
+	return append([]Scope{}, scopes...), ok
+}
+
+// HasScope reports whether the access token is granted the scope.
+func (i *AuthorizationInfo) HasScope(s Scope) bool {
+	for _, granted := range i.Scopes {
+		if Scope(granted) == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tokenCall is a request of the authorization info in flight, shared by the concurrent calls of TokenInfo.
+type tokenCall struct {
+	done chan struct{}
+	info *AuthorizationInfo
+	err  error
+}
+
+// TokenInfo gets the authorization info of the access token, fetching it once for the lifetime of the client.
+// Concurrent calls share a single request, a failed request is sent again by the next call, as well as
+// by the waiting calls when it was canceled with the context of the call that sent it.
+func (c *Client) TokenInfo(ctx context.Context) (*AuthorizationInfo, error) {
+	for {
+		c.tokenMu.Lock()
+		if c.tokenInfo != nil {
+			info := c.tokenInfo
+			c.tokenMu.Unlock()
+			return info, nil
+		}
+
+		call := c.tokenCall
+		if call == nil {
+			call = &tokenCall{done: make(chan struct{})}
+			c.tokenCall = call
+			c.tokenMu.Unlock()
+
+			// The request is sent as AuthzInfoService.Get, which requires no scope, whatever the method checked.
+			call.info, _, call.err = c.AuthzInfo.Get(context.WithValue(ctx, methodKey{}, "AuthzInfoService.Get"))
+
+			c.tokenMu.Lock()
+			// The info is dropped when ResetTokenInfo was called during the request.
+			if c.tokenCall == call {
+				c.tokenCall = nil
+				if call.err == nil {
+					c.tokenInfo = call.info
+				}
+			}
+			c.tokenMu.Unlock()
+			close(call.done)
+
+			return call.info, call.err
+		}
+		c.tokenMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-call.done:
+		}
+
+		if isContextError(call.err) && ctx.Err() == nil {
+			continue
+		}
+
+		return call.info, call.err
+	}
+}
+
+// ResetTokenInfo drops the cached authorization info, for example after the access token changed scopes.
+func (c *Client) ResetTokenInfo() {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.tokenInfo = nil
+	c.tokenCall = nil
+}
+
+// CheckScopes checks that the access token is granted the scopes the service method requires, the method
+// being named by its service type and method like BoardsService.Get. The returned error is *MissingScopeError
+// when a scope is missing. The token info is only fetched when the method requires a scope.
+func (c *Client) CheckScopes(ctx context.Context, method string) error {
+	required, ok := methodScopes[method]
+	if !ok {
+		return fmt.Errorf("unknown method: %s", method)
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	info, err := c.TokenInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	missing := []Scope{}
+	for _, s := range required {
+		if !info.HasScope(s) {
+			missing = append(missing, s)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	granted := make([]Scope, 0, len(info.Scopes))
+	for _, s := range info.Scopes {
+		granted = append(granted, Scope(s))
+	}
+
+	return &MissingScopeError{Method: method, Missing: missing, Granted: granted}
+}
+
+// methodKey is the context key of the service method sending the requests.
+type methodKey struct{}
+
+// withMethod returns ctx carrying the service method, named like BoardsService.Get, the scopes of which are
+// checked by the requests sent with ctx. A method already carried is kept, so that the methods composed of
+// other methods, and the goroutines they start, are checked for every scope they require by their first request.
+func withMethod(ctx context.Context, method string) context.Context {
+	if _, ok := ctx.Value(methodKey{}).(string); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// checkMethodScopes checks the scopes of the service method carried by ctx, when the client fails on
+// missing scopes. Requests not sent by a service method, or sent by a method of unknown scopes, are not checked.
+func (c *Client) checkMethodScopes(ctx context.Context) error {
+	if !c.FailOnMissingScope {
+		return nil
+	}
+
+	method, ok := ctx.Value(methodKey{}).(string)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := methodScopes[method]; !ok {
+		return nil
+	}
+
+	return c.CheckScopes(ctx, method)
+}
+
+// joinScopes returns the scopes sorted and separated by commas.
+func joinScopes(scopes []Scope) string {
+	s := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		s = append(s, string(scope))
+	}
+	sort.Strings(s)
+
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,330 @@
+package miro
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRequiredScopes(t *testing.T) {
+	client := NewClient(testAccessKey)
+
+	// The methods calling undocumented endpoints have unknown scopes.
+	unknown := map[string]bool{
+		"OrganizationsService.DeactivateMember": true,
+		"OrganizationsService.ReactivateMember": true,
+	}
+
+	services := []interface{}{}
+	for _, v := range []reflect.Value{reflect.ValueOf(client).Elem(), reflect.ValueOf(client.V2).Elem()} {
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Kind() == reflect.Ptr && strings.HasSuffix(f.Type().Elem().Name(), "Service") {
+				services = append(services, f.Interface())
+			}
+		}
+	}
+
+	for _, s := range services {
+		typ := reflect.TypeOf(s)
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := fmt.Sprintf("%s.%s", typ.Elem().Name(), typ.Method(i).Name)
+			if _, ok := RequiredScopes(method); ok == unknown[method] {
+				t.Errorf("Scopes of %s not expected, known:%v", method, ok)
+			}
+		}
+	}
+}
+
+func TestServiceMethods_WithMethod(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	for _, f := range pkgs["miro"].Files {
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok || !strings.HasSuffix(star.X.(*ast.Ident).Name, "Service") {
+				continue
+			}
+
+			method := fmt.Sprintf("%s.%s", star.X.(*ast.Ident).Name, fn.Name.Name)
+			want := fmt.Sprintf("ctx = withMethod(ctx, %q)", method)
+
+			got := &bytes.Buffer{}
+			if len(fn.Body.List) > 0 {
+				format.Node(got, fset, fn.Body.List[0])
+			}
+
+			if got.String() != want {
+				t.Errorf("%s should start with %s, got:%s", method, want, got)
+			}
+		}
+	}
+}
+
+func TestClient_CheckScopes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc(fmt.Sprintf("/%s", AuthorizationInfoPath), func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, getAuthorizationInfoJSON("1"))
+	})
+
+	tcs := map[string]struct {
+		method string
+		want   *MissingScopeError
+	}{
+		"granted":  {"BoardsService.Get", nil},
+		"no scope": {"AuthzInfoService.Get", nil},
+		"missing": {"BoardsService.Create", &MissingScopeError{
+			Method:  "BoardsService.Create",
+			Missing: []Scope{ScopeBoardsWrite},
+			Granted: []Scope{ScopeBoardsRead, ScopeTeamRead},
+		}},
+		"partly missing": {"WidgetsService.MoveIntoFrame", &MissingScopeError{
+			Method:  "WidgetsService.MoveIntoFrame",
+			Missing: []Scope{ScopeBoardsWrite},
+			Granted: []Scope{ScopeBoardsRead, ScopeTeamRead},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			err := client.CheckScopes(context.Background(), tc.method)
+			if tc.want == nil {
+				if err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrMissingScope) {
+				t.Fatalf("Should failed")
+			}
+
+			var got *MissingScopeError
+			if !errors.As(err, &got) {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Fatalf("Token info should be fetched once, got:%d", requests)
+	}
+
+	if err := client.CheckScopes(context.Background(), "BoardsService.Unknown"); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
+
+func TestClient_TokenInfo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc(fmt.Sprintf("/%s", AuthorizationInfoPath), func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, getAuthorizationInfoJSON(fmt.Sprint(requests)))
+	})
+
+	for i := 0; i < 2; i++ {
+		got, err := client.TokenInfo(context.Background())
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if diff := cmp.Diff(got, getAuthorizationInfo("1")); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	}
+
+	client.ResetTokenInfo()
+
+	got, err := client.TokenInfo(context.Background())
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, getAuthorizationInfo("2")); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestClient_TokenInfo_Concurrent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := 0
+	started, release := make(chan struct{}), make(chan struct{})
+	mux.HandleFunc(fmt.Sprintf("/%s", AuthorizationInfoPath), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		if requests == 1 {
+			close(started)
+		}
+		mu.Unlock()
+
+		<-release
+		fmt.Fprint(w, getAuthorizationInfoJSON("1"))
+	})
+
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, err := client.TokenInfo(context.Background())
+			errs <- err
+		}()
+	}
+
+	// A caller giving up does not wait for the request in flight.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	<-started
+	if _, err := client.TokenInfo(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Should failed")
+	}
+
+	close(release)
+	for i := 0; i < 5; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("Token info should be fetched once, got:%d", requests)
+	}
+}
+
+func TestClient_TokenInfo_Canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := 0
+	release := make(chan struct{})
+	mux.HandleFunc(fmt.Sprintf("/%s", AuthorizationInfoPath), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		<-release
+		fmt.Fprint(w, getAuthorizationInfoJSON("1"))
+	})
+	sent := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := client.TokenInfo(ctx)
+		canceled <- err
+	}()
+
+	for sent() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The waiting call sends the request again once the call that sent it gave up.
+	got := make(chan error)
+	go func() {
+		_, err := client.TokenInfo(context.Background())
+		got <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Should failed")
+	}
+	close(release)
+
+	if err := <-got; err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if sent() != 2 {
+		t.Fatalf("Token info should be fetched again, got:%d", sent())
+	}
+}
+
+func TestClient_FailOnMissingScope(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.FailOnMissingScope = true
+
+	requests := map[string]int{}
+	mux.HandleFunc(fmt.Sprintf("/%s", AuthorizationInfoPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getAuthorizationInfoJSON("1"))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/", boardsPath), func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method]++
+		fmt.Fprint(w, getBoardJSON("1"))
+	})
+
+	if _, _, err := client.Boards.Get(context.Background(), "1"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	_, err := client.Boards.Delete(context.Background(), "1")
+	if !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Should failed")
+	}
+
+	want := "missing scope, method:BoardsService.Delete, missing:boards:write, granted:boards:read,team:read"
+	if err.Error() != want {
+		t.Fatalf("Diff: got:%s, want:%s", err.Error(), want)
+	}
+
+	if _, _, err := client.Widgets.MoveIntoFrame(context.Background(), "1", "frame", "widget"); !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Should failed")
+	}
+
+	// The workers of the bulk operations check the scopes of the operation.
+	_, err = client.Widgets.CreateMany(context.Background(), "1", []Widget{&Sticker{Text: "a"}, &Sticker{Text: "b"}}, nil)
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || len(bulkErr.Failed) != 2 || !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Should failed")
+	}
+
+	var scopeErr *MissingScopeError
+	if !errors.As(bulkErr.Failed[1].Err, &scopeErr) || scopeErr.Method != "WidgetsService.CreateMany" {
+		t.Fatalf("Failed: %v", bulkErr.Failed[1].Err)
+	}
+
+	if diff := cmp.Diff(requests, map[string]int{http.MethodGet: 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
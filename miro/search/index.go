@@ -0,0 +1,163 @@
+// Package search indexes the widgets of a Miro board locally for full-text and spatial search.
+//
+// An Index holds the widgets of a board, fetched with WidgetsService.List or taken from a
+// snapshot of package export, and answers queries combining words, types, colors, tags,
+// authors, dates and positions without calling Miro. It is kept up to date with the
+// changes detected by package watch.
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/watch"
+)
+
+// Index is an in-memory index of widgets, safe for concurrent use.
+type Index struct {
+	mu      sync.RWMutex
+	widgets map[string]miro.Widget
+
+	// postings maps the words to the IDs of the widgets containing them.
+	postings map[string]map[string]bool
+
+	// words maps the IDs of the widgets to their words, to remove them from postings.
+	words map[string][]string
+}
+
+// NewIndex returns a new index of the widgets.
+func NewIndex(widgets []miro.Widget) *Index {
+	i := &Index{
+		widgets:  map[string]miro.Widget{},
+		postings: map[string]map[string]bool{},
+		words:    map[string][]string{},
+	}
+
+	for _, w := range widgets {
+		i.add(w)
+	}
+
+	return i
+}
+
+// Len returns the number of widgets in the index.
+func (i *Index) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.widgets)
+}
+
+// Get returns the widget by Widget ID, nil if not in the index.
+func (i *Index) Get(id string) miro.Widget {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.widgets[id]
+}
+
+// Put adds the widget to the index, replacing the widget with the same ID.
+func (i *Index) Put(w miro.Widget) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.add(w)
+}
+
+// Remove removes the widget by Widget ID.
+func (i *Index) Remove(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+}
+
+// Apply updates the index with the changes, as sent by watch.Watcher or returned by watch.Diff.
+func (i *Index) Apply(changes []*watch.Change) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, c := range changes {
+		if c.Type == watch.ChangeRemoved {
+			i.remove(c.WidgetID)
+		} else if c.After != nil {
+			i.add(c.After)
+		}
+	}
+}
+
+func (i *Index) add(w miro.Widget) {
+	id := w.Base().ID
+	i.remove(id)
+
+	i.widgets[id] = w
+	words := tokenize(content(w))
+	i.words[id] = words
+	for _, t := range words {
+		if i.postings[t] == nil {
+			i.postings[t] = map[string]bool{}
+		}
+		i.postings[t][id] = true
+	}
+}
+
+func (i *Index) remove(id string) {
+	for _, t := range i.words[id] {
+		delete(i.postings[t], id)
+		if len(i.postings[t]) == 0 {
+			delete(i.postings, t)
+		}
+	}
+
+	delete(i.words, id)
+	delete(i.widgets, id)
+}
+
+// match returns the IDs of the widgets containing every word of the text, a word matching the words
+// it prefixes. The second value is false when the text has no word, which matches every widget.
+func (i *Index) match(text string) (map[string]bool, bool) {
+	terms := tokenize(text)
+	if len(terms) == 0 {
+		return nil, false
+	}
+
+	var ids map[string]bool
+	for _, term := range terms {
+		found := map[string]bool{}
+		for word, postings := range i.postings {
+			if !strings.HasPrefix(word, term) {
+				continue
+			}
+
+			for id := range postings {
+				if ids == nil || ids[id] {
+					found[id] = true
+				}
+			}
+		}
+
+		ids = found
+		if len(ids) == 0 {
+			break
+		}
+	}
+
+	return ids, true
+}
+
+// readingOrder sorts the widgets by their top left corner, top to bottom then left to right, then by ID.
+func readingOrder(widgets []miro.Widget) {
+	sort.Slice(widgets, func(a, b int) bool {
+		ra, rb := Bounds(widgets[a]), Bounds(widgets[b])
+		if ra.Y != rb.Y {
+			return ra.Y < rb.Y
+		}
+		if ra.X != rb.X {
+			return ra.X < rb.X
+		}
+
+		return widgets[a].Base().ID < widgets[b].Base().ID
+	})
+}
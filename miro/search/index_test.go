@@ -0,0 +1,169 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/watch"
+	"github.com/google/go-cmp/cmp"
+)
+
+func day(d int) time.Time {
+	return time.Date(2022, 3, d, 0, 0, 0, 0, time.UTC)
+}
+
+// board returns the widgets of a risk review board.
+func board() []miro.Widget {
+	yellow := &miro.WidgetStyle{BackgroundColor: "#FFF9B1"}
+	red := &miro.WidgetStyle{BackgroundColor: "#f24726"}
+	alice := &miro.MiniUser{ID: "alice"}
+	bob := &miro.MiniUser{ID: "bob"}
+
+	return []miro.Widget{
+		&miro.Frame{WidgetBase: miro.WidgetBase{ID: "risks", Type: miro.WidgetTypeFrame, X: 500, Y: 500, Width: 1000, Height: 1000}, Title: "<p>Risks</p>"},
+		&miro.Frame{WidgetBase: miro.WidgetBase{ID: "ideas", Type: miro.WidgetTypeFrame, X: 1600, Y: 500, Width: 1000, Height: 1000}, Title: "Ideas"},
+		&miro.Sticker{
+			WidgetBase: miro.WidgetBase{ID: "s1", Type: miro.WidgetTypeSticker, X: 100, Y: 100, Width: 100, Height: 100, CreatedBy: alice, CreatedAt: day(1)},
+			Text:       "<p>Budget cut in Q3</p>",
+			Style:      red,
+			Tags:       []*miro.Tag{{Title: "Finance"}},
+		},
+		&miro.Sticker{
+			WidgetBase: miro.WidgetBase{ID: "s2", Type: miro.WidgetTypeSticker, X: 300, Y: 100, Width: 100, Height: 100, CreatedBy: bob, CreatedAt: day(2)},
+			Text:       "<p>Hiring &amp; budgets</p>",
+			Style:      yellow,
+		},
+		&miro.Sticker{
+			WidgetBase: miro.WidgetBase{ID: "s3", Type: miro.WidgetTypeSticker, X: 1200, Y: 100, Width: 100, Height: 100, CreatedBy: alice, CreatedAt: day(3)},
+			Text:       "<p>Budget dashboard</p>",
+			Style:      yellow,
+		},
+		&miro.Card{
+			WidgetBase:  miro.WidgetBase{ID: "c1", Type: miro.WidgetTypeCard, X: 300, Y: 400, Width: 300, Height: 100, CreatedBy: bob, CreatedAt: day(4)},
+			Title:       "Review the budget",
+			Description: "<p>With finance</p>",
+			Tags:        []*miro.Tag{{Title: "finance"}},
+		},
+		&miro.Text{WidgetBase: miro.WidgetBase{ID: "t1", Type: miro.WidgetTypeText, X: 500, Y: -100, Width: 400, Height: 50}, Text: "Vendor lock-in"},
+	}
+}
+
+func ids(widgets []miro.Widget) []string {
+	var got []string
+	for _, w := range widgets {
+		got = append(got, w.Base().ID)
+	}
+
+	return got
+}
+
+func TestIndex_Search(t *testing.T) {
+	i := NewIndex(board())
+
+	tcs := map[string]struct {
+		q    *Query
+		want []string
+	}{
+		"all": {
+			want: []string{"t1", "risks", "ideas", "s1", "s2", "s3", "c1"},
+		},
+		"text": {
+			q:    &Query{Text: "budget"},
+			want: []string{"s1", "s2", "s3", "c1"},
+		},
+		"every word": {
+			q:    &Query{Text: "BUDGET cut"},
+			want: []string{"s1"},
+		},
+		"escaped text": {
+			q:    &Query{Text: "hiring & budgets"},
+			want: []string{"s2"},
+		},
+		"hyphenated": {
+			q:    &Query{Text: "lock-in"},
+			want: []string{"t1"},
+		},
+		"description": {
+			q:    &Query{Text: "finance"},
+			want: []string{"c1"},
+		},
+		"no match": {
+			q:    &Query{Text: "budget roadmap"},
+			want: nil,
+		},
+		"stickers in the Risks frame mentioning budget": {
+			q:    &Query{Text: "budget", Types: []miro.WidgetType{miro.WidgetTypeSticker}, Frame: "Risks"},
+			want: []string{"s1", "s2"},
+		},
+		"frame by ID": {
+			q:    &Query{Frame: "ideas"},
+			want: []string{"s3"},
+		},
+		"unknown frame": {
+			q:    &Query{Frame: "Actions"},
+			want: nil,
+		},
+		"color": {
+			q:    &Query{Colors: []string{"#fff9b1"}},
+			want: []string{"s2", "s3"},
+		},
+		"tag": {
+			q:    &Query{Tags: []string{"FINANCE"}},
+			want: []string{"s1", "c1"},
+		},
+		"author": {
+			q:    &Query{CreatedBy: []string{"alice"}},
+			want: []string{"s1", "s3"},
+		},
+		"created": {
+			q:    &Query{CreatedAfter: day(2), CreatedBefore: day(4)},
+			want: []string{"s2", "s3"},
+		},
+		"within": {
+			q:    &Query{Within: &Rect{X: 0, Y: 0, Width: 400, Height: 200}},
+			want: []string{"s1", "s2"},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := ids(i.Search(tc.q))
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestIndex_Apply(t *testing.T) {
+	before := board()
+	i := NewIndex(before)
+
+	after := board()
+	after[2] = &miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s1", Type: miro.WidgetTypeSticker, X: 100, Y: 100}, Text: "Headcount freeze"}
+	after = append(after[:3], after[4:]...)
+	after = append(after, &miro.Sticker{WidgetBase: miro.WidgetBase{ID: "s4", Type: miro.WidgetTypeSticker, X: 200, Y: 200}, Text: "Budget overrun"})
+
+	i.Apply(watch.Diff(before, after))
+
+	if diff := cmp.Diff(ids(i.Search(&Query{Text: "budget"})), []string{"s3", "s4", "c1"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(ids(i.Search(&Query{Text: "headcount"})), []string{"s1"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(i.Len(), 7); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if i.Get("s2") != nil {
+		t.Fatalf("Should remove s2")
+	}
+
+	if diff := cmp.Diff(len(i.postings["hiring"]), 0); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
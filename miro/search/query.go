@@ -0,0 +1,180 @@
+package search
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Query represents the criteria of a search, all of which a widget must match.
+// The zero value matches every widget.
+type Query struct {
+	// Text matches the widgets containing every word of it, case insensitively,
+	// a word matching the words it prefixes. The text of stickers, shapes and
+	// texts, the title and description of cards and the title of frames are searched.
+	Text string
+
+	// Types matches the widgets of one of the types.
+	Types []miro.WidgetType
+
+	// Colors matches the widgets of one of the background colors, case insensitively.
+	Colors []string
+
+	// Tags matches the widgets with one of the tags by title, case insensitively.
+	Tags []string
+
+	// CreatedBy matches the widgets created by one of the users by User ID.
+	CreatedBy []string
+
+	// CreatedAfter and CreatedBefore match the widgets created in the range, zero for no bound.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// ModifiedAfter and ModifiedBefore match the widgets modified in the range, zero for no bound.
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+
+	// Within matches the widgets entirely inside the rectangle.
+	Within *Rect
+
+	// Frame matches the widgets overlapping the frame, other than the frame itself, by Widget ID,
+	// or by title when no frame has the ID. An unknown frame matches no widget.
+	Frame string
+}
+
+// Search returns the widgets matching the query, nil matching every widget, in reading order.
+func (i *Index) Search(q *Query) []miro.Widget {
+	if q == nil {
+		q = &Query{}
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ids, ok := i.match(q.Text)
+
+	var frame *miro.Frame
+	if q.Frame != "" {
+		if frame = i.frame(q.Frame); frame == nil {
+			return nil
+		}
+	}
+
+	var widgets []miro.Widget
+	for id, w := range i.widgets {
+		if ok && !ids[id] {
+			continue
+		}
+
+		if frame != nil && (id == frame.ID || !Bounds(frame).Overlaps(Bounds(w))) {
+			continue
+		}
+
+		if q.matches(w) {
+			widgets = append(widgets, w)
+		}
+	}
+	readingOrder(widgets)
+
+	return widgets
+}
+
+// frame returns the frame by Widget ID or by title, ignoring case and formatting, nil if not found.
+func (i *Index) frame(idOrTitle string) *miro.Frame {
+	if f, ok := i.widgets[idOrTitle].(*miro.Frame); ok {
+		return f
+	}
+
+	title := strings.Join(tokenize(idOrTitle), " ")
+	var found *miro.Frame
+	for _, w := range i.widgets {
+		f, ok := w.(*miro.Frame)
+		if !ok || strings.Join(tokenize(f.Title), " ") != title {
+			continue
+		}
+
+		// The lowest ID, so that the same frame is found every time.
+		if found == nil || f.ID < found.ID {
+			found = f
+		}
+	}
+
+	return found
+}
+
+// matches reports whether the widget matches the criteria of the query other than Text and Frame.
+func (q *Query) matches(w miro.Widget) bool {
+	b := w.Base()
+
+	if len(q.Types) > 0 && !contains(q.Types, b.Type) {
+		return false
+	}
+
+	if len(q.Colors) > 0 {
+		s := style(w)
+		if s == nil || !containsFold(q.Colors, s.BackgroundColor) {
+			return false
+		}
+	}
+
+	if len(q.Tags) > 0 {
+		found := false
+		for _, t := range tags(w) {
+			if containsFold(q.Tags, t.Title) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(q.CreatedBy) > 0 && (b.CreatedBy == nil || !contains(q.CreatedBy, b.CreatedBy.ID)) {
+		return false
+	}
+
+	if !inRange(b.CreatedAt, q.CreatedAfter, q.CreatedBefore) || !inRange(b.ModifiedAt, q.ModifiedAfter, q.ModifiedBefore) {
+		return false
+	}
+
+	if q.Within != nil && !q.Within.Contains(Bounds(w)) {
+		return false
+	}
+
+	return true
+}
+
+func inRange(t, after, before time.Time) bool {
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+
+	if !before.IsZero() && !t.Before(before) {
+		return false
+	}
+
+	return true
+}
+
+func contains[T comparable](s []T, v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(s []string, v string) bool {
+	for _, e := range s {
+		if strings.EqualFold(e, v) {
+			return true
+		}
+	}
+
+	return false
+}
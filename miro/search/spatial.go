@@ -0,0 +1,86 @@
+package search
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// Rect represents a rectangle on a board by its top left corner and its size.
+type Rect struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// Bounds returns the rectangle of the widget, from its center and its size.
+func Bounds(w miro.Widget) Rect {
+	b := w.Base()
+	return Rect{X: b.X - b.Width/2, Y: b.Y - b.Height/2, Width: b.Width, Height: b.Height}
+}
+
+// Contains reports whether the other rectangle is entirely inside the rectangle.
+func (r Rect) Contains(o Rect) bool {
+	return o.X >= r.X && o.Y >= r.Y && o.X+o.Width <= r.X+r.Width && o.Y+o.Height <= r.Y+r.Height
+}
+
+// Overlaps reports whether the rectangles share a point, edges included.
+func (r Rect) Overlaps(o Rect) bool {
+	return o.X <= r.X+r.Width && r.X <= o.X+o.Width && o.Y <= r.Y+r.Height && r.Y <= o.Y+o.Height
+}
+
+// distance returns the distance from the point to the rectangle, 0 when inside.
+func (r Rect) distance(p miro.Point) float64 {
+	dx := math.Max(math.Max(r.X-p.X, 0), p.X-(r.X+r.Width))
+	dy := math.Max(math.Max(r.Y-p.Y, 0), p.Y-(r.Y+r.Height))
+	return math.Hypot(dx, dy)
+}
+
+// Within returns the widgets entirely inside the rectangle, in reading order.
+func (i *Index) Within(r Rect) []miro.Widget {
+	return i.Search(&Query{Within: &r})
+}
+
+// Overlapping returns the widgets overlapping the frame by Widget ID, in reading order,
+// nil if the frame is not in the index.
+func (i *Index) Overlapping(frameID string) []miro.Widget {
+	i.mu.RLock()
+	_, ok := i.widgets[frameID].(*miro.Frame)
+	i.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	return i.Search(&Query{Frame: frameID})
+}
+
+// Nearest returns at most n widgets matching the query, nil matching every widget, the nearest to
+// the point first. The distance to a widget is the distance to its rectangle, 0 when the point is inside.
+// No widget is returned when n is not positive.
+func (i *Index) Nearest(p miro.Point, n int, q *Query) []miro.Widget {
+	if n <= 0 {
+		return []miro.Widget{}
+	}
+
+	if q == nil {
+		q = &Query{}
+	}
+
+	widgets := i.Search(q)
+	dist := make(map[string]float64, len(widgets))
+	for _, w := range widgets {
+		dist[w.Base().ID] = Bounds(w).distance(p)
+	}
+
+	sort.SliceStable(widgets, func(a, b int) bool {
+		return dist[widgets[a].Base().ID] < dist[widgets[b].Base().ID]
+	})
+
+	if len(widgets) > n {
+		widgets = widgets[:n]
+	}
+
+	return widgets
+}
@@ -0,0 +1,84 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRect(t *testing.T) {
+	r := Rect{X: 0, Y: 0, Width: 100, Height: 100}
+
+	tcs := map[string]struct {
+		o        Rect
+		contains bool
+		overlaps bool
+	}{
+		"inside":  {Rect{X: 10, Y: 10, Width: 50, Height: 50}, true, true},
+		"across":  {Rect{X: 50, Y: 50, Width: 100, Height: 100}, false, true},
+		"touches": {Rect{X: 100, Y: 0, Width: 10, Height: 10}, false, true},
+		"outside": {Rect{X: 200, Y: 200, Width: 10, Height: 10}, false, false},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			if diff := cmp.Diff([]bool{r.Contains(tc.o), r.Overlaps(tc.o)}, []bool{tc.contains, tc.overlaps}); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestIndex_Within(t *testing.T) {
+	i := NewIndex(board())
+
+	got := ids(i.Within(Rect{X: 0, Y: 0, Width: 1000, Height: 1000}))
+	if diff := cmp.Diff(got, []string{"risks", "s1", "s2", "c1"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestIndex_Overlapping(t *testing.T) {
+	i := NewIndex(board())
+
+	got := ids(i.Overlapping("ideas"))
+	if diff := cmp.Diff(got, []string{"s3"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if got := i.Overlapping("s1"); got != nil {
+		t.Fatalf("Should return nil for a widget other than a frame, got:%v", ids(got))
+	}
+}
+
+func TestIndex_Nearest(t *testing.T) {
+	i := NewIndex(board())
+
+	tcs := map[string]struct {
+		n    int
+		q    *Query
+		want []string
+	}{
+		"any": {
+			n:    3,
+			want: []string{"risks", "s2", "s1"},
+		},
+		"stickers": {
+			n:    5,
+			q:    &Query{Types: []miro.WidgetType{miro.WidgetTypeSticker}},
+			want: []string{"s2", "s1", "s3"},
+		},
+		"none":     {n: 0},
+		"negative": {n: -1},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := ids(i.Nearest(miro.Point{X: 300, Y: 100}, tc.n, tc.q))
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+var (
+	htmlTags = regexp.MustCompile(`<[^>]*>`)
+)
+
+// content returns the text of the widget indexed for full-text search, as formatted by Miro.
+func content(w miro.Widget) string {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return w.Text
+	case *miro.Shape:
+		return w.Text
+	case *miro.Text:
+		return w.Text
+	case *miro.Card:
+		return w.Title + " " + w.Description
+	case *miro.Frame:
+		return w.Title
+	}
+
+	return ""
+}
+
+// tokenize returns the distinct lower case words of the rich text, which Miro formats as HTML.
+func tokenize(s string) []string {
+	s = htmlTags.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+
+	seen := map[string]bool{}
+	var tokens []string
+	for _, t := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !seen[t] {
+			seen[t] = true
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens
+}
+
+// style returns the style of the widget, nil if it has none.
+func style(w miro.Widget) *miro.WidgetStyle {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return w.Style
+	case *miro.Shape:
+		return w.Style
+	case *miro.Text:
+		return w.Style
+	case *miro.Card:
+		return w.Style
+	case *miro.Frame:
+		return w.Style
+	}
+
+	return nil
+}
+
+// tags returns the tags of the widget.
+func tags(w miro.Widget) []*miro.Tag {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return w.Tags
+	case *miro.Card:
+		return w.Tags
+	}
+
+	return nil
+}
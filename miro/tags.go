@@ -0,0 +1,250 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TagsService handles communication to Miro Tags API.
+//
+// API doc: https://developers.miro.com/reference#tag-object
+type TagsService service
+
+// TagColor represents the color of a tag.
+type TagColor string
+
+const (
+	TagColorRed        TagColor = "red"
+	TagColorMagenta    TagColor = "magenta"
+	TagColorViolet     TagColor = "violet"
+	TagColorLightGreen TagColor = "light_green"
+	TagColorGreen      TagColor = "green"
+	TagColorDarkGreen  TagColor = "dark_green"
+	TagColorCyan       TagColor = "cyan"
+	TagColorBlue       TagColor = "blue"
+	TagColorDarkBlue   TagColor = "dark_blue"
+	TagColorYellow     TagColor = "yellow"
+	TagColorGray       TagColor = "gray"
+	TagColorBlack      TagColor = "black"
+)
+
+// Tag object represents Miro Tag attached to widgets.
+// WidgetIDs are the IDs of the widgets the tag is attached to, set by the tags API only.
+//
+// API doc: https://developers.miro.com/reference#tag-object
+//
+//go:generate gomodifytags -file $GOFILE -struct Tag -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct Tag -add-tags json -w -transform camelcase
+type Tag struct {
+	ID        string   `json:"id,omitempty"`
+	Title     string   `json:"title"`
+	Color     TagColor `json:"color,omitempty"`
+	WidgetIDs []string `json:"widgetIds,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TagRequest represents create and update tag request payload.
+// WidgetIDs are the widgets to attach the tag to on creation, use Attach and Detach to change them.
+//
+//go:generate gomodifytags -file $GOFILE -struct TagRequest -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TagRequest -add-tags json -w -transform camelcase
+type TagRequest struct {
+	Title     string   `json:"title,omitempty"`
+	Color     TagColor `json:"color,omitempty"`
+	WidgetIDs []string `json:"widgetIds,omitempty"`
+}
+
+// listTagsResponse represents list tags response from Miro.
+type listTagsResponse struct {
+	Type string `json:"type"`
+	Data []*Tag `json:"data"`
+}
+
+// tagWidgetsRequest represents update tag widgets request payload, sent even when empty.
+type tagWidgetsRequest struct {
+	WidgetIDs []string `json:"widgetIds"`
+}
+
+// List lists the tags on the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference#get-board-tags
+func (s *TagsService) List(ctx context.Context, boardID string) ([]*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.List")
+
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, tagsPath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, resp, err := do[listTagsResponse](ctx, s.client, req, http.StatusOK)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return list.Data, resp, nil
+}
+
+// Get gets the tag on the board by Board ID and Tag ID.
+//
+// API doc: https://developers.miro.com/reference#get-tag
+func (s *TagsService) Get(ctx context.Context, boardID, tagID string) (*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.Get")
+
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Tag](ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates the tag on the board.
+//
+// API doc: https://developers.miro.com/reference#create-tag
+func (s *TagsService) Create(ctx context.Context, boardID string, request *TagRequest) (*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.Create")
+
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, tagsPath), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Tag](ctx, s.client, req, http.StatusOK, http.StatusCreated)
+}
+
+// Update updates the title and the color of the tag on the board by Board ID and Tag ID.
+//
+// API doc: https://developers.miro.com/reference#update-tag
+func (s *TagsService) Update(ctx context.Context, boardID, tagID string, request *TagRequest) (*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.Update")
+
+	return s.patch(ctx, boardID, tagID, request)
+}
+
+// Delete deletes the tag on the board by Board ID and Tag ID, detaching it from its widgets.
+//
+// API doc: https://developers.miro.com/reference#delete-tag
+func (s *TagsService) Delete(ctx context.Context, boardID, tagID string) (*Response, error) {
+	ctx = withMethod(ctx, "TagsService.Delete")
+
+	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID))
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// Attach attaches the tag to the widgets. The widgets the tag is attached to already are kept.
+// The tag is read then written back with its widgets, so calls changing the same tag at the same time
+// can overwrite each other and are not safe to run concurrently. TagsV2Service.Attach attaches a tag
+// to a single item without reading it.
+func (s *TagsService) Attach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.Attach")
+
+	t, resp, err := s.Get(ctx, boardID, tagID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ids := append([]string{}, t.WidgetIDs...)
+	for _, id := range widgetIDs {
+		if !t.HasWidget(id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return s.patch(ctx, boardID, tagID, &tagWidgetsRequest{WidgetIDs: ids})
+}
+
+// Detach detaches the tag from the widgets. The tag is kept even when attached to no widget.
+// Like Attach, calls changing the same tag are not safe to run concurrently.
+func (s *TagsService) Detach(ctx context.Context, boardID, tagID string, widgetIDs ...string) (*Tag, *Response, error) {
+	ctx = withMethod(ctx, "TagsService.Detach")
+
+	t, resp, err := s.Get(ctx, boardID, tagID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	detach := map[string]bool{}
+	for _, id := range widgetIDs {
+		detach[id] = true
+	}
+
+	ids := []string{}
+	for _, id := range t.WidgetIDs {
+		if !detach[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	return s.patch(ctx, boardID, tagID, &tagWidgetsRequest{WidgetIDs: ids})
+}
+
+func (s *TagsService) patch(ctx context.Context, boardID, tagID string, body interface{}) (*Tag, *Response, error) {
+	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Tag](ctx, s.client, req, http.StatusOK)
+}
+
+// ListByTag lists the widgets on the board the tag is attached to, by Board ID and Tag ID.
+// An empty widget type lists the widgets of every type.
+func (s *WidgetsService) ListByTag(ctx context.Context, boardID, tagID string, widgetType WidgetType) ([]Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.ListByTag")
+
+	t, resp, err := (*TagsService)(s).Get(ctx, boardID, tagID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	widgets, resp, err := s.List(ctx, boardID, widgetType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tagged := []Widget{}
+	for _, w := range widgets {
+		if t.HasWidget(w.Base().ID) {
+			tagged = append(tagged, w)
+		}
+	}
+
+	return tagged, resp, nil
+}
+
+// HasWidget reports whether the tag is attached to the widget.
+func (t *Tag) HasWidget(widgetID string) bool {
+	for _, id := range t.WidgetIDs {
+		if id == widgetID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnmarshalJSON decodes the tag keeping the members unknown to this package in Extra.
+func (t *Tag) UnmarshalJSON(data []byte) error {
+	type alias Tag
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the tag with the members in Extra.
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type alias Tag
+	return marshalExtra(alias(t), t.Extra)
+}
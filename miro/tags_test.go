@@ -0,0 +1,251 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getTagJSON(id string, widgetIDs ...string) string {
+	ids, _ := json.Marshal(widgetIDs)
+	return fmt.Sprintf(`{"id": "%s", "title": "retro", "color": "violet", "widgetIds": %s}`, id, ids)
+}
+
+func getTag(id string, widgetIDs ...string) *Tag {
+	return &Tag{ID: id, Title: "retro", Color: TagColorViolet, WidgetIDs: widgetIDs}
+}
+
+func TestTagsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		want    []*Tag
+	}{
+		"ok": {"board", []*Tag{getTag("1", "w1"), getTag("2")}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"type": "collection", "data": [%s, %s]}`, getTagJSON("1", "w1"), getTagJSON("2"))
+			})
+
+			got, _, err := client.Tags.List(context.Background(), tc.boardID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestTagsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getTagJSON("1", "w1"))
+	})
+
+	tcs := map[string]struct {
+		tagID string
+		want  *Tag
+		err   bool
+	}{
+		"ok":        {"1", getTag("1", "w1"), false},
+		"not found": {"2", nil, true},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, _, err := client.Tags.Get(context.Background(), "board", tc.tagID)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("Should failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestTagsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var got string
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		got = string(body["title"]) + " " + string(body["color"]) + " " + string(body["widgetIds"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, getTagJSON("1", "w1"))
+	})
+
+	tag, _, err := client.Tags.Create(context.Background(), "board", &TagRequest{Title: "retro", Color: TagColorViolet, WidgetIDs: []string{"w1"}})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, `"retro" "violet" ["w1"]`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(tag, getTag("1", "w1")); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTagsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var got string
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		b, _ := json.Marshal(body)
+		got = string(b)
+
+		fmt.Fprint(w, `{"id": "1", "title": "retro", "color": "red"}`)
+	})
+
+	tag, _, err := client.Tags.Update(context.Background(), "board", "1", &TagRequest{Color: TagColorRed})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, `{"color":"red"}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(tag, &Tag{ID: "1", Title: "retro", Color: TagColorRed}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTagsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Tags.Delete(context.Background(), "board", "1"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
+
+func TestTagsService_AttachDetach(t *testing.T) {
+	tcs := map[string]struct {
+		attach    bool
+		widgetIDs []string
+		want      string
+	}{
+		"attach":          {true, []string{"w2", "w3"}, `{"widgetIds":["w1","w2","w3"]}`},
+		"attach attached": {true, []string{"w2", "w1"}, `{"widgetIds":["w1","w2"]}`},
+		"detach":          {false, []string{"w1", "w3"}, `{"widgetIds":["w2"]}`},
+		"detach all":      {false, []string{"w1", "w2"}, `{"widgetIds":[]}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var got string
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					fmt.Fprint(w, getTagJSON("1", "w1", "w2"))
+				case http.MethodPatch:
+					var body map[string]json.RawMessage
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatalf("Failed: %v", err)
+					}
+					b, _ := json.Marshal(body)
+					got = string(b)
+
+					fmt.Fprintf(w, `{"id": "1", "title": "retro", "widgetIds": %s}`, body["widgetIds"])
+				}
+			})
+
+			var err error
+			if tc.attach {
+				_, _, err = client.Tags.Attach(context.Background(), "board", "1", tc.widgetIDs...)
+			} else {
+				_, _, err = client.Tags.Detach(context.Background(), "board", "1", tc.widgetIDs...)
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_ListByTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/1", boardsPath, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getTagJSON("1", "w1", "w3"))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff(r.URL.Query().Get("widgetType"), "sticker"); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		fmt.Fprint(w, `{"type": "collection", "data": [
+	{"id": "w1", "type": "sticker", "text": "keep"},
+	{"id": "w2", "type": "sticker", "text": "stop"}
+]}`)
+	})
+
+	got, _, err := client.Widgets.ListByTag(context.Background(), "board", "1", WidgetTypeSticker)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []Widget{&Sticker{WidgetBase: WidgetBase{ID: "w1", Type: WidgetTypeSticker}, Text: "keep"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
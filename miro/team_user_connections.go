@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -18,21 +17,33 @@ const (
 // API doc: https://developers.miro.com/reference#team-user-connection-object
 type TeamUserConnectionService service
 
+// TeamRole represents the role of a user in a team.
+type TeamRole string
+
+const (
+	TeamRoleMember  TeamRole = "member"
+	TeamRoleAdmin   TeamRole = "admin"
+	TeamRoleNonTeam TeamRole = "non_team"
+)
+
 // TeamUserConnection object represents Miro TeamUserConnection.
 //
 // API doc: https://developers.miro.com/reference#team-user-connection-object
+//
 //go:generate gomodifytags -file $GOFILE -struct TeamUserConnection -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct TeamUserConnection -add-tags json -w -transform camelcase
 type TeamUserConnection struct {
 	ID         string    `json:"id"`
 	User       *MiniUser `json:"user"`
 	Team       *MiniTeam `json:"team"`
-	Role       string    `json:"role"`
+	Role       TeamRole  `json:"role"`
 	Name       string    `json:"name"`
 	CreatedAt  time.Time `json:"createdAt"`
 	ModifiedAt time.Time `json:"modifiedAt"`
 	CreatedBy  *MiniUser `json:"createdBy"`
 	ModifiedBy *MiniUser `json:"modifiedBy"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 //go:generate gomodifytags -file $GOFILE -struct MiniTeamUserConnection -clear-tags -w
@@ -40,33 +51,23 @@ type TeamUserConnection struct {
 type MiniTeamUserConnection struct {
 	ID   string    `json:"id"`
 	User *MiniUser `json:"user"`
-	Role string    `json:"role"`
+	Role TeamRole  `json:"role"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Get gets team user connection by TeamUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#get-team-user-connection
-func (s *TeamUserConnectionService) Get(ctx context.Context, id string) (*TeamUserConnection, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", teamUserConnectionsPath, id))
-	if err != nil {
-		return nil, err
-	}
+func (s *TeamUserConnectionService) Get(ctx context.Context, id string) (*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamUserConnectionService.Get")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", teamUserConnectionsPath, id))
 	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	conn := &TeamUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return conn, nil
+	return do[TeamUserConnection](ctx, s.client, req, http.StatusOK)
 }
 
 // UpdateTeamUserConnectionRequest represents request to update team user connection
@@ -74,191 +75,70 @@ func (s *TeamUserConnectionService) Get(ctx context.Context, id string) (*TeamUs
 //go:generate gomodifytags -file $GOFILE -struct UpdateTeamUserConnectionRequest -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct UpdateTeamUserConnectionRequest -add-tags json -w -transform camelcase
 type UpdateTeamUserConnectionRequest struct {
-	Role string `json:"role"`
+	Role TeamRole `json:"role"`
 }
 
 // Update updates team user connection by TeamUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#update-team-user-connection
-func (s *TeamUserConnectionService) Update(ctx context.Context, id string, request *UpdateTeamUserConnectionRequest) (*TeamUserConnection, error) {
+func (s *TeamUserConnectionService) Update(ctx context.Context, id string, request *UpdateTeamUserConnectionRequest) (*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamUserConnectionService.Update")
+
 	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", teamUserConnectionsPath, id), request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	conn := &TeamUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return nil, err
-	}
-
-	return conn, nil
+	return do[TeamUserConnection](ctx, s.client, req, http.StatusOK)
 }
 
 // Delete deletes team user connection by TeamUserConnection ID.
 //
 // API doc: https://developers.miro.com/reference#delete-team-user-connection
-func (s *TeamUserConnectionService) Delete(ctx context.Context, id string) error {
-	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", teamUserConnectionsPath, id))
-	if err != nil {
-		return err
-	}
+func (s *TeamUserConnectionService) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withMethod(ctx, "TeamUserConnectionService.Delete")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s", teamUserConnectionsPath, id))
 	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+		return nil, err
 	}
 
-	return nil
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusOK, http.StatusNoContent)
+	return resp, err
 }
 
-func (t *TeamUserConnection) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
-
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the team user connection keeping the members unknown to this package in Extra.
+func (t *TeamUserConnection) UnmarshalJSON(data []byte) error {
+	type alias TeamUserConnection
+	extra, err := unmarshalExtra(data, (*alias)(t))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			t.ID = v.(string)
-		}
-
-		if strings.ToLower(k) == "name" {
-			t.Name = v.(string)
-		}
-
-		if strings.ToLower(k) == "role" {
-			t.Role = v.(string)
-		}
-
-		if strings.ToLower(k) == "createdAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			t.CreatedAt = at
-		}
-
-		if strings.ToLower(k) == "modifiedAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			t.ModifiedAt = at
-		}
-
-		if strings.ToLower(k) == "user" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
-
-			t.User = user
-		}
-
-		if strings.ToLower(k) == "team" {
-			team := &MiniTeam{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					team.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "name" {
-					team.Name = v.(string)
-				}
-			}
-
-			t.Team = team
-		}
-
-		if strings.ToLower(k) == "createdBy" {
-			user := &MiniUser{}
-			u := v.(map[string]interface{})
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v.(string)
-				}
-			}
-
-			t.CreatedBy = user
-		}
-
-		if strings.ToLower(k) == "modifiedBy" {
-			user := &MiniUser{}
-			u := v.(map[string]string)
-
-			for k, v := range u {
-				if strings.ToLower(k) == "id" {
-					user.ID = v
-				}
-
-				if strings.ToLower(k) == "name" {
-					user.Name = v
-				}
-			}
-
-			t.ModifiedBy = user
-		}
-	}
-
+	t.Extra = extra
 	return nil
 }
 
-func (t *MiniTeamUserConnection) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]string
+// MarshalJSON encodes the team user connection with the members in Extra.
+func (t TeamUserConnection) MarshalJSON() ([]byte, error) {
+	type alias TeamUserConnection
+	return marshalExtra(alias(t), t.Extra)
+}
 
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the mini team user connection keeping the members unknown to this package in Extra.
+func (t *MiniTeamUserConnection) UnmarshalJSON(data []byte) error {
+	type alias MiniTeamUserConnection
+	extra, err := unmarshalExtra(data, (*alias)(t))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			t.ID = v
-		}
-
-		if strings.ToLower(k) == "role" {
-			t.Role = v
-		}
-
-		if strings.ToLower(k) == "user" {
-			u := &MiniUser{}
-			if err := json.Unmarshal([]byte(v), u); err != nil {
-				return err
-			}
-
-			t.User = u
-		}
-	}
-
+	t.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the mini team user connection with the members in Extra.
+func (t MiniTeamUserConnection) MarshalJSON() ([]byte, error) {
+	type alias MiniTeamUserConnection
+	return marshalExtra(alias(t), t.Extra)
+}
@@ -52,9 +52,11 @@ func getTeamUserConnection(id string) *TeamUserConnection {
 		ID:   id,
 		Role: "admin",
 		User: &MiniUser{
-			ID:   "user",
-			Name: "Sergey",
+			ID:    "user",
+			Name:  "Sergey",
+			Extra: extra("type", `"user"`),
 		},
+		Extra: extra("type", `"team-user-connection"`),
 	}
 }
 
@@ -79,7 +81,7 @@ func TestTeamUserConnectionService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamUserConnectionJSON(tc.id)))
 			})
 
-			got, err := client.TeamUserConnection.Get(context.Background(), tc.id)
+			got, _, err := client.TeamUserConnection.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -111,7 +113,7 @@ func TestTeamUserConnectionService_Update(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamUserConnectionJSON(tc.id)))
 			})
 
-			got, err := client.TeamUserConnection.Update(context.Background(), tc.id, tc.req)
+			got, _, err := client.TeamUserConnection.Update(context.Background(), tc.id, tc.req)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -140,7 +142,7 @@ func TestTeamUserConnectionService_Delete(t *testing.T) {
 				fmt.Fprint(w, "{}")
 			})
 
-			err := client.TeamUserConnection.Delete(context.Background(), tc.id)
+			_, err := client.TeamUserConnection.Delete(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
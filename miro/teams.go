@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -13,6 +14,8 @@ const (
 	teamsPath           = "teams"
 	userConnectionsPath = "user-connections"
 	teamInvitePath      = "invite"
+	orgsPath            = "orgs"
+	teamSettingsPath    = "settings"
 )
 
 // TeamsService handles communication to Miro Teams API.
@@ -23,6 +26,7 @@ type TeamsService service
 // Team object represents Miro Team.
 //
 // API doc: https://developers.miro.com/reference#team-object
+//
 //go:generate gomodifytags -file $GOFILE -struct Team -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Team -add-tags json -w -transform camelcase
 type Team struct {
@@ -33,6 +37,8 @@ type Team struct {
 	CreatedBy  *MiniUser    `json:"createdBy"`
 	ModifiedBy *MiniUser    `json:"modifiedBy"`
 	Picture    *MiniPicture `json:"picture"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 func (t *Team) GetType() string {
@@ -42,6 +48,8 @@ func (t *Team) GetType() string {
 type MiniTeam struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 func (t *MiniTeam) GetType() string {
@@ -51,27 +59,15 @@ func (t *MiniTeam) GetType() string {
 // Get gets team by Team ID.
 //
 // API doc: https://developers.miro.com/reference#get-team
-func (s *TeamsService) Get(ctx context.Context, id string) (*Team, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", teamsPath, id))
-	if err != nil {
-		return nil, err
-	}
+func (s *TeamsService) Get(ctx context.Context, id string) (*Team, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.Get")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", teamsPath, id))
 	if err != nil {
-		return nil, err
-	}
-
-	if http.StatusBadRequest <= resp.StatusCode && resp.StatusCode <= http.StatusInsufficientStorage {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	t := &Team{}
-	if err := json.NewDecoder(resp.Body).Decode(t); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return t, nil
+	return do[Team](ctx, s.client, req, http.StatusOK)
 }
 
 // UpdateTeamRequest represents request to update team user connection
@@ -85,27 +81,15 @@ type UpdateTeamRequest struct {
 // Update updates team by Team ID.
 //
 // API doc: https://developers.miro.com/reference#update-team
-func (s *TeamsService) Update(ctx context.Context, id string, request *UpdateTeamRequest) (*Team, error) {
-	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", teamsPath, id), request)
-	if err != nil {
-		return nil, err
-	}
+func (s *TeamsService) Update(ctx context.Context, id string, request *UpdateTeamRequest) (*Team, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.Update")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s", teamsPath, id), request)
 	if err != nil {
-		return nil, err
-	}
-
-	if http.StatusBadRequest <= resp.StatusCode && resp.StatusCode <= http.StatusInsufficientStorage {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	t := &Team{}
-	if err := json.NewDecoder(resp.Body).Decode(t); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return t, nil
+	return do[Team](ctx, s.client, req, http.StatusOK)
 }
 
 // ListTeamMembersResponse represents list response from Miro
@@ -113,149 +97,454 @@ func (s *TeamsService) Update(ctx context.Context, id string, request *UpdateTea
 //go:generate gomodifytags -file $GOFILE -struct ListTeamMembersResponse -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct ListTeamMembersResponse -add-tags json -w -transform camelcase
 type ListTeamMembersResponse struct {
-	Limit  int     `json:"limit"`
-	Offset int     `json:"offset"`
-	Size   int     `json:"size"`
-	Data   []*Team `json:"data"`
+	Limit  int                   `json:"limit"`
+	Offset int                   `json:"offset"`
+	Size   int                   `json:"size"`
+	Data   []*TeamUserConnection `json:"data"`
 }
 
-// ListTeamMembers gets all team members
+// ListTeamMembers lists team user connections of the team by Team ID.
 //
 // API doc: https://developers.miro.com/reference#get-team-user-connections
-func (s *TeamsService) ListTeamMembers(ctx context.Context, id string) (*ListTeamMembersResponse, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", teamsPath, id))
-	if err != nil {
-		return nil, err
-	}
+func (s *TeamsService) ListTeamMembers(ctx context.Context, id string, opt *ListOptions) (*ListTeamMembersResponse, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.ListTeamMembers")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(addListOptions(fmt.Sprintf("%s/%s/%s", teamsPath, id, userConnectionsPath), opt))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if http.StatusBadRequest <= resp.StatusCode && resp.StatusCode <= http.StatusInsufficientStorage {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
+	return do[ListTeamMembersResponse](ctx, s.client, req, http.StatusOK)
+}
 
-	t := &ListTeamMembersResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(t); err != nil {
-		return nil, err
-	}
+// ListAllTeamMembers lists every team user connection of the team by following the pagination.
+func (s *TeamsService) ListAllTeamMembers(ctx context.Context, id string) ([]*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.ListAllTeamMembers")
+
+	opt := &ListOptions{}
+	conns := []*TeamUserConnection{}
 
-	return t, nil
+	for {
+		page, resp, err := s.ListTeamMembers(ctx, id, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		conns = append(conns, page.Data...)
+		opt.Offset += len(page.Data)
+
+		if len(page.Data) == 0 || opt.Offset >= page.Size {
+			return conns, resp, nil
+		}
+	}
 }
 
 // GetCurrentUserConnection gets team current user connection by Team ID.
 //
 // API doc: https://developers.miro.com/reference#get-team-current-user-connection
-func (s *TeamsService) GetCurrentUserConnection(ctx context.Context, id string) (*TeamUserConnection, error) {
+func (s *TeamsService) GetCurrentUserConnection(ctx context.Context, id string) (*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.GetCurrentUserConnection")
+
 	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/%s/me", teamsPath, id, userConnectionsPath))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
+	return do[TeamUserConnection](ctx, s.client, req, http.StatusOK)
+}
+
+// Invite invites passed user to specified team.
+//
+// API doc: https://developers.miro.com/reference#invite-to-team
+func (s *TeamsService) Invite(ctx context.Context, id string, email string) ([]*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.Invite")
+
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s/%s?email=%s", teamsPath, id, userConnectionsPath, teamInvitePath, url.QueryEscape(email)), nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+	conns, resp, err := do[[]*TeamUserConnection](ctx, s.client, req, http.StatusOK)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	conn := &TeamUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(conn); err != nil {
-		return nil, err
-	}
+	return *conns, resp, nil
+}
 
-	return conn, nil
+// InviteManyRequest represents invite to team request payload with several emails.
+//
+//go:generate gomodifytags -file $GOFILE -struct InviteManyRequest -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct InviteManyRequest -add-tags json -w -transform camelcase
+type InviteManyRequest struct {
+	Emails []string `json:"emails"`
 }
 
-// Invite invites passed user to specified team.
+// InviteMany invites the emails to the team by Team ID in a single request.
 //
 // API doc: https://developers.miro.com/reference#invite-to-team
-func (s *TeamsService) Invite(ctx context.Context, id string, email string) ([]*TeamUserConnection, error) {
-	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s/%s?email=%s", teamsPath, id, userConnectionsPath, teamInvitePath, email), nil)
+func (s *TeamsService) InviteMany(ctx context.Context, id string, request *InviteManyRequest) ([]*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.InviteMany")
+
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s/%s", teamsPath, id, userConnectionsPath, teamInvitePath), request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req)
+	conns, resp, err := do[[]*TeamUserConnection](ctx, s.client, req, http.StatusOK)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+	return *conns, resp, nil
+}
+
+// TeamInvitation represents an invitee of the team and the role to grant.
+type TeamInvitation struct {
+	Email string
+	Role  TeamRole
+}
+
+// InviteWithRolesRequest represents invite to team request payload with per-email roles.
+type InviteWithRolesRequest struct {
+	Invitations []*TeamInvitation
+}
+
+// InviteError is returned by TeamsService.InviteWithRoles and BoardsService.ShareWithRoles
+// when some of the invitations failed.
+type InviteError struct {
+	// Invited are the emails invited with their role.
+	Invited []string
+
+	// Failed are the emails not invited or not granted their role.
+	Failed []string
+
+	// Err is the first error.
+	Err error
+}
+
+func (e *InviteError) Error() string {
+	return fmt.Sprintf("%d of %d invitations failed, failed:%s, first error:%v", len(e.Failed), len(e.Invited)+len(e.Failed), strings.Join(e.Failed, ","), e.Err)
+}
+
+// Unwrap returns the first error.
+func (e *InviteError) Unwrap() error {
+	return e.Err
+}
+
+// InviteWithRoles invites the emails to the team by Team ID granting each email its own role.
+// The emails are grouped by role and each group is invited in a single request.
+// Since Miro invites as members, the role of the other invitees is updated after their invitation,
+// the connections returned by an invitation being matched to the emails in order.
+// An empty role invites as a member.
+//
+// Every invitation is tried even if some fail, the connections of the emails invited with their role
+// are returned with *InviteError telling the emails invited and the emails failed.
+//
+// API doc: https://developers.miro.com/reference#invite-to-team
+func (s *TeamsService) InviteWithRoles(ctx context.Context, id string, request *InviteWithRolesRequest) ([]*TeamUserConnection, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.InviteWithRoles")
+
+	roles := []TeamRole{}
+	groups := map[TeamRole][]string{}
+	for _, inv := range request.Invitations {
+		if _, ok := groups[inv.Role]; !ok {
+			roles = append(roles, inv.Role)
+		}
+		groups[inv.Role] = append(groups[inv.Role], inv.Email)
 	}
 
+	var resp *Response
 	conns := []*TeamUserConnection{}
-	if err := json.NewDecoder(resp.Body).Decode(&conns); err != nil {
+	invErr := &InviteError{Invited: []string{}, Failed: []string{}}
+	fail := func(err error, emails ...string) {
+		invErr.Failed = append(invErr.Failed, emails...)
+		if invErr.Err == nil {
+			invErr.Err = err
+		}
+	}
+
+	for _, role := range roles {
+		emails := groups[role]
+
+		invited, r, err := s.InviteMany(ctx, id, &InviteManyRequest{Emails: emails})
+		resp = r
+		if err != nil {
+			fail(err, emails...)
+			continue
+		}
+
+		for i, conn := range invited {
+			if role != "" && conn.Role != role {
+				updated, r, err := (*TeamUserConnectionService)(s).Update(ctx, conn.ID, &UpdateTeamUserConnectionRequest{Role: role})
+				resp = r
+				if err != nil {
+					if i < len(emails) {
+						fail(err, emails[i])
+					}
+					continue
+				}
+				conn = updated
+			}
+
+			if i < len(emails) {
+				invErr.Invited = append(invErr.Invited, emails[i])
+			}
+			conns = append(conns, conn)
+		}
+
+		// The emails without connection, if any, were invited all the same.
+		if len(invited) < len(emails) {
+			invErr.Invited = append(invErr.Invited, emails[len(invited):]...)
+		}
+	}
+
+	if invErr.Err != nil {
+		return conns, resp, invErr
+	}
+
+	return conns, resp, nil
+}
+
+// CreateTeamRequest represents create team request payload.
+//
+//go:generate gomodifytags -file $GOFILE -struct CreateTeamRequest -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct CreateTeamRequest -add-tags json -w -transform camelcase
+type CreateTeamRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListByOrganization lists the teams of the organization by Organization ID.
+// The API v1 has no organization endpoint, the Enterprise API v2 is called.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-teams
+func (s *TeamsService) ListByOrganization(ctx context.Context, orgID string, opt *CursorOptions) (*ListTeamsResponse, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.ListByOrganization")
+
+	v := url.Values{}
+	addCursorOptions(v, opt)
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", orgsPath, orgID, teamsPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListTeamsResponse](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAllByOrganization lists every team of the organization by following the cursor.
+func (s *TeamsService) ListAllByOrganization(ctx context.Context, orgID string) ([]*Team, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.ListAllByOrganization")
+
+	return listAllCursor(ctx, func(ctx context.Context, opt *CursorOptions) (*ListTeamsResponse, *Response, error) {
+		return s.ListByOrganization(ctx, orgID, opt)
+	})
+}
+
+// Create creates the team in the organization by Organization ID.
+// The API v1 has no endpoint to create teams, the Enterprise API v2 is called.
+//
+// API doc: https://developers.miro.com/reference/enterprise-create-team
+func (s *TeamsService) Create(ctx context.Context, orgID string, request *CreateTeamRequest) (*Team, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.Create")
+
+	req, err := s.client.newV2Request(http.MethodPost, fmt.Sprintf("%s/%s/%s", orgsPath, orgID, teamsPath), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[Team](ctx, s.client, req, http.StatusOK, http.StatusCreated)
+}
+
+// Delete deletes the team of the organization by Organization ID and Team ID, with the boards of the team.
+// The API v1 has no endpoint to delete teams, the Enterprise API v2 is called.
+//
+// API doc: https://developers.miro.com/reference/enterprise-delete-team
+func (s *TeamsService) Delete(ctx context.Context, orgID, id string) (*Response, error) {
+	ctx = withMethod(ctx, "TeamsService.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s", orgsPath, orgID, teamsPath, id), nil)
+	if err != nil {
 		return nil, err
 	}
 
-	return conns, nil
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// TeamInviteExternalUsers represents whether users outside the organization can be invited to a team.
+type TeamInviteExternalUsers string
+
+const (
+	TeamInviteExternalUsersAllowed    TeamInviteExternalUsers = "allowed"
+	TeamInviteExternalUsersNotAllowed TeamInviteExternalUsers = "not_allowed"
+)
+
+// TeamWhoCanInvite represents who can invite users to a team.
+type TeamWhoCanInvite string
+
+const (
+	TeamWhoCanInviteOnlyOrgAdmins        TeamWhoCanInvite = "only_org_admins"
+	TeamWhoCanInviteAdmins               TeamWhoCanInvite = "admins"
+	TeamWhoCanInviteAdminsAndMembers     TeamWhoCanInvite = "admins_and_members"
+	TeamWhoCanInviteAdminsMembersNonTeam TeamWhoCanInvite = "admins_members_non_team"
+)
+
+// TeamSettings object represents the settings of Miro Team.
+// Only the settings set are changed by UpdateSettings.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-team-settings
+//
+//go:generate gomodifytags -file $GOFILE -struct TeamSettings -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TeamSettings -add-tags json -w -transform camelcase
+type TeamSettings struct {
+	TeamID                    string                     `json:"teamId,omitempty"`
+	OrganizationID            string                     `json:"organizationId,omitempty"`
+	TeamSharingPolicySettings *TeamSharingPolicySettings `json:"teamSharingPolicySettings,omitempty"`
+	TeamInvitationSettings    *TeamInvitationSettings    `json:"teamInvitationSettings,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TeamSharingPolicySettings represents the sharing defaults of the boards of a team.
+//
+//go:generate gomodifytags -file $GOFILE -struct TeamSharingPolicySettings -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TeamSharingPolicySettings -add-tags json -w -transform camelcase
+type TeamSharingPolicySettings struct {
+	DefaultBoardAccess        AccessLevel `json:"defaultBoardAccess,omitempty"`
+	DefaultOrganizationAccess AccessLevel `json:"defaultOrganizationAccess,omitempty"`
+	SharingViaPublicLink      string      `json:"sharingViaPublicLink,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TeamInvitationSettings represents the invitation policy of a team.
+//
+//go:generate gomodifytags -file $GOFILE -struct TeamInvitationSettings -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TeamInvitationSettings -add-tags json -w -transform camelcase
+type TeamInvitationSettings struct {
+	InviteExternalUsers TeamInviteExternalUsers `json:"inviteExternalUsers,omitempty"`
+	WhoCanInvite        TeamWhoCanInvite        `json:"whoCanInvite,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-func (t *Team) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]string
+// GetSettings gets the settings of the team of the organization by Organization ID and Team ID.
+// The API v1 has no endpoint for team settings, the Enterprise API v2 is called.
+//
+// API doc: https://developers.miro.com/reference/enterprise-get-team-settings
+func (s *TeamsService) GetSettings(ctx context.Context, orgID, id string) (*TeamSettings, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.GetSettings")
 
-	err := json.Unmarshal(j, &rawStrings)
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s/%s", orgsPath, orgID, teamsPath, id, teamSettingsPath), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[TeamSettings](ctx, s.client, req, http.StatusOK)
+}
+
+// UpdateSettings updates the settings of the team of the organization by Organization ID and Team ID.
+//
+// API doc: https://developers.miro.com/reference/enterprise-update-team-settings
+func (s *TeamsService) UpdateSettings(ctx context.Context, orgID, id string, request *TeamSettings) (*TeamSettings, *Response, error) {
+	ctx = withMethod(ctx, "TeamsService.UpdateSettings")
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s/%s", orgsPath, orgID, teamsPath, id, teamSettingsPath), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[TeamSettings](ctx, s.client, req, http.StatusOK)
+}
+
+// UnmarshalJSON decodes the team keeping the members unknown to this package in Extra.
+func (t *Team) UnmarshalJSON(data []byte) error {
+	type alias Team
+	extra, err := unmarshalExtra(data, (*alias)(t))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			t.ID = v
-		}
-		if strings.ToLower(k) == "name" {
-			t.Name = v
-		}
+	t.Extra = extra
+	return nil
+}
 
-		if strings.ToLower(k) == "createdAt" {
-			at, err := time.Parse(time.RFC3339, v)
-			if err != nil {
-				return err
-			}
-			t.CreatedAt = at
-		}
+// MarshalJSON encodes the team with the members in Extra.
+func (t Team) MarshalJSON() ([]byte, error) {
+	type alias Team
+	return marshalExtra(alias(t), t.Extra)
+}
 
-		if strings.ToLower(k) == "modifiedAt" {
-			at, err := time.Parse(time.RFC3339, v)
-			if err != nil {
-				return err
-			}
-			t.ModifiedAt = at
-		}
+// UnmarshalJSON decodes the mini team keeping the members unknown to this package in Extra.
+func (t *MiniTeam) UnmarshalJSON(data []byte) error {
+	type alias MiniTeam
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
 
-		if strings.ToLower(k) == "createdBy" {
-			u := &MiniUser{}
-			if err := json.Unmarshal([]byte(v), u); err != nil {
-				return err
-			}
+	t.Extra = extra
+	return nil
+}
 
-			t.CreatedBy = u
-		}
+// MarshalJSON encodes the mini team with the members in Extra.
+func (t MiniTeam) MarshalJSON() ([]byte, error) {
+	type alias MiniTeam
+	return marshalExtra(alias(t), t.Extra)
+}
 
-		if strings.ToLower(k) == "modifiedBy" {
-			u := &MiniUser{}
-			if err := json.Unmarshal([]byte(v), u); err != nil {
-				return err
-			}
+// UnmarshalJSON decodes the team settings keeping the members unknown to this package in Extra.
+func (t *TeamSettings) UnmarshalJSON(data []byte) error {
+	type alias TeamSettings
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
 
-			t.ModifiedBy = u
-		}
+	t.Extra = extra
+	return nil
+}
 
-		if strings.ToLower(k) == "picture" {
-			p := &MiniPicture{}
-			if err := json.Unmarshal([]byte(v), p); err != nil {
-				return err
-			}
+// MarshalJSON encodes the team settings with the members in Extra.
+func (t TeamSettings) MarshalJSON() ([]byte, error) {
+	type alias TeamSettings
+	return marshalExtra(alias(t), t.Extra)
+}
 
-			t.Picture = p
-		}
+// UnmarshalJSON decodes the sharing policy settings keeping the members unknown to this package in Extra.
+func (p *TeamSharingPolicySettings) UnmarshalJSON(data []byte) error {
+	type alias TeamSharingPolicySettings
+	extra, err := unmarshalExtra(data, (*alias)(p))
+	if err != nil {
+		return err
 	}
 
+	p.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the sharing policy settings with the members in Extra.
+func (p TeamSharingPolicySettings) MarshalJSON() ([]byte, error) {
+	type alias TeamSharingPolicySettings
+	return marshalExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON decodes the invitation settings keeping the members unknown to this package in Extra.
+func (i *TeamInvitationSettings) UnmarshalJSON(data []byte) error {
+	type alias TeamInvitationSettings
+	extra, err := unmarshalExtra(data, (*alias)(i))
+	if err != nil {
+		return err
+	}
+
+	i.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the invitation settings with the members in Extra.
+func (i TeamInvitationSettings) MarshalJSON() ([]byte, error) {
+	type alias TeamInvitationSettings
+	return marshalExtra(alias(i), i.Extra)
+}
@@ -2,8 +2,12 @@ package miro
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,8 +28,8 @@ func getTeamJSON(id string) string {
 }
 
 func getTeam(id string) *Team {
-	modifiedAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
-	createdAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
+	modifiedAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
+	createdAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
 
 	return &Team{
 		ID:         id,
@@ -52,7 +56,7 @@ func TestTeamsService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamJSON(tc.id)))
 			})
 
-			got, err := client.Teams.Get(context.Background(), tc.id)
+			got, _, err := client.Teams.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -84,7 +88,7 @@ func TestTeamsService_Update(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamJSON(tc.id)))
 			})
 
-			got, err := client.Teams.Update(context.Background(), tc.id, tc.req)
+			got, _, err := client.Teams.Update(context.Background(), tc.id, tc.req)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -113,7 +117,7 @@ func TestTeamsService_GetCurrentUserConnection(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamUserConnectionJSON(tc.id)))
 			})
 
-			got, err := client.Teams.GetCurrentUserConnection(context.Background(), tc.id)
+			got, _, err := client.Teams.GetCurrentUserConnection(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -143,7 +147,7 @@ func TestTeamsService_Invite(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getTeamUserConnectionsJSON(tc.id)))
 			})
 
-			got, err := client.Teams.Invite(context.Background(), tc.id, tc.email)
+			got, _, err := client.Teams.Invite(context.Background(), tc.id, tc.email)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -154,3 +158,243 @@ func TestTeamsService_Invite(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamsService_ListAllTeamMembers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/1/%s", teamsPath, userConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprintf(w, `{"limit": 2, "offset": 0, "size": 3, "data": [%s, %s]}`, getTeamUserConnectionJSON("1"), getTeamUserConnectionJSON("2"))
+		case "2":
+			fmt.Fprintf(w, `{"limit": 2, "offset": 2, "size": 3, "data": [%s]}`, getTeamUserConnectionJSON("3"))
+		default:
+			t.Fatalf("unexpected offset: %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	got, _, err := client.Teams.ListAllTeamMembers(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []*TeamUserConnection{getTeamUserConnection("1"), getTeamUserConnection("2"), getTeamUserConnection("3")}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTeamsService_InviteWithRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	invites := [][]string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/1/%s/%s", teamsPath, userConnectionsPath, teamInvitePath), func(w http.ResponseWriter, r *http.Request) {
+		var body InviteManyRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		invites = append(invites, body.Emails)
+
+		conns := []string{}
+		for _, email := range body.Emails {
+			id := strings.Split(email, "@")[0]
+			conns = append(conns, fmt.Sprintf(`{"id": "%s", "user": {"id": "%s"}, "role": "member"}`, id, id))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(conns, ","))
+	})
+
+	updated := map[string]string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/", teamUserConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/%s/", teamUserConnectionsPath))
+		if id == "erin" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var body UpdateTeamUserConnectionRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		updated[id] = string(body.Role)
+
+		fmt.Fprintf(w, `{"id": "%s", "user": {"id": "%s"}, "role": "%s"}`, id, id, body.Role)
+	})
+
+	got, _, err := client.Teams.InviteWithRoles(context.Background(), "1", &InviteWithRolesRequest{Invitations: []*TeamInvitation{
+		{Email: "alice@test.com", Role: TeamRoleAdmin},
+		{Email: "bob@test.com"},
+		{Email: "carol@test.com", Role: TeamRoleMember},
+		{Email: "dave@test.com", Role: TeamRoleAdmin},
+	}})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(invites, [][]string{{"alice@test.com", "dave@test.com"}, {"bob@test.com"}, {"carol@test.com"}}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(updated, map[string]string{"alice": "admin", "dave": "admin"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	roles := []string{}
+	for _, c := range got {
+		roles = append(roles, fmt.Sprintf("%s %s", c.ID, c.Role))
+	}
+
+	if diff := cmp.Diff(roles, []string{"alice admin", "dave admin", "bob member", "carol member"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	t.Run("failed", func(t *testing.T) {
+		got, _, err := client.Teams.InviteWithRoles(context.Background(), "1", &InviteWithRolesRequest{Invitations: []*TeamInvitation{
+			{Email: "erin@test.com", Role: TeamRoleAdmin},
+			{Email: "frank@test.com"},
+			{Email: "grace@test.com", Role: TeamRoleAdmin},
+		}})
+
+		var invErr *InviteError
+		if !errors.As(err, &invErr) {
+			t.Fatalf("Should failed")
+		}
+
+		if diff := cmp.Diff([][]string{invErr.Invited, invErr.Failed}, [][]string{{"grace@test.com", "frank@test.com"}, {"erin@test.com"}}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+
+		var respErr *RespError
+		if !errors.As(err, &respErr) || respErr.Status != http.StatusInternalServerError {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		roles := []string{}
+		for _, c := range got {
+			roles = append(roles, fmt.Sprintf("%s %s", c.ID, c.Role))
+		}
+
+		if diff := cmp.Diff(roles, []string{"grace admin", "frank member"}); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	})
+}
+
+func TestTeamsService_ListAllByOrganization(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s", orgsPath, teamsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprintf(w, `{"data": [%s], "cursor": "next"}`, getTeamJSON("1"))
+			return
+		}
+
+		fmt.Fprintf(w, `{"data": [%s]}`, getTeamJSON("2"))
+	})
+
+	got, _, err := client.Teams.ListAllByOrganization(context.Background(), "org")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, []*Team{getTeam("1"), getTeam("2")}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTeamsService_CreateDelete(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s", orgsPath, teamsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		body = strings.TrimSpace(string(b))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, getTeamJSON("1"))
+	})
+
+	deleted := false
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s/1", orgsPath, teamsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("method not expected, got:%s", r.Method)
+		}
+
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	got, _, err := client.Teams.Create(context.Background(), "org", &CreateTeamRequest{Name: "Design"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(body, `{"name":"Design"}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(got, getTeam("1")); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if _, err := client.Teams.Delete(context.Background(), "org", "1"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if !deleted {
+		t.Fatalf("Should delete the team")
+	}
+}
+
+func TestTeamsService_Settings(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc(fmt.Sprintf("/%s/org/%s/1/%s", orgsPath, teamsPath, teamSettingsPath), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			b, _ := io.ReadAll(r.Body)
+			body = strings.TrimSpace(string(b))
+		}
+
+		fmt.Fprint(w, `{
+	"teamId": "1",
+	"organizationId": "org",
+	"teamSharingPolicySettings": {"defaultBoardAccess": "private", "defaultOrganizationAccess": "view"},
+	"teamInvitationSettings": {"inviteExternalUsers": "not_allowed", "whoCanInvite": "admins"}
+}`)
+	})
+
+	want := &TeamSettings{
+		TeamID:                    "1",
+		OrganizationID:            "org",
+		TeamSharingPolicySettings: &TeamSharingPolicySettings{DefaultBoardAccess: AccessLevelPrivate, DefaultOrganizationAccess: AccessLevelView},
+		TeamInvitationSettings:    &TeamInvitationSettings{InviteExternalUsers: TeamInviteExternalUsersNotAllowed, WhoCanInvite: TeamWhoCanInviteAdmins},
+	}
+
+	got, _, err := client.Teams.GetSettings(context.Background(), "org", "1")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if _, _, err := client.Teams.UpdateSettings(context.Background(), "org", "1", &TeamSettings{
+		TeamInvitationSettings: &TeamInvitationSettings{WhoCanInvite: TeamWhoCanInviteAdmins},
+	}); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(body, `{"teamInvitationSettings":{"whoCanInvite":"admins"}}`); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
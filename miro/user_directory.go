@@ -0,0 +1,336 @@
+package miro
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUserDirectoryTTL  = time.Hour
+	defaultUserDirectorySize = 1000
+)
+
+// UserDirectoryOptions specifies the optional parameters of UserDirectory.
+type UserDirectoryOptions struct {
+	// TTL is how long a user is cached before being fetched again. Zero uses an hour.
+	TTL time.Duration
+
+	// Size is the number of users cached, the least recently used being evicted first. Zero uses 1000.
+	Size int
+
+	// Concurrency is the number of users fetched at the same time by the prefetches. Zero uses 4.
+	Concurrency int
+}
+
+// UserDirectory resolves User IDs and MiniUser references to full users, caching them.
+// Concurrent lookups of the same user share a single request. It is safe for concurrent use.
+type UserDirectory struct {
+	client *Client
+	opt    UserDirectoryOptions
+	now    func() time.Time
+
+	mu sync.Mutex
+
+	// entries maps the User IDs to their elements in lru, the most recently used first.
+	entries map[string]*list.Element
+	lru     *list.List
+
+	// calls are the requests in flight by User ID.
+	calls map[string]*userCall
+}
+
+type userEntry struct {
+	id      string
+	user    *User
+	expires time.Time
+}
+
+type userCall struct {
+	done chan struct{}
+	user *User
+	resp *Response
+	err  error
+}
+
+// NewUserDirectory returns a new user directory fetching the users with the client.
+func NewUserDirectory(client *Client, opt *UserDirectoryOptions) *UserDirectory {
+	d := &UserDirectory{
+		client:  client,
+		now:     time.Now,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		calls:   map[string]*userCall{},
+	}
+
+	if opt != nil {
+		d.opt = *opt
+	}
+	if d.opt.TTL <= 0 {
+		d.opt.TTL = defaultUserDirectoryTTL
+	}
+	if d.opt.Size <= 0 {
+		d.opt.Size = defaultUserDirectorySize
+	}
+	if d.opt.Concurrency <= 0 {
+		d.opt.Concurrency = defaultBulkConcurrency
+	}
+
+	return d
+}
+
+// Get gets the user by User ID from the cache, fetching it when missing or expired.
+// A lookup of a user already being fetched waits for that request instead of sending another one,
+// and fails with its error, or with the error of ctx when done first. The request is sent again when
+// the lookup that sent it gave up.
+func (d *UserDirectory) Get(ctx context.Context, id string) (*User, error) {
+	u, _, err := d.get(ctx, id)
+	return u, err
+}
+
+// get gets the user like Get, with the response of the request when this lookup sent it.
+// A lookup waiting for a request canceled with the context of the lookup that sent it, while its own
+// context is not done, sends the request again.
+func (d *UserDirectory) get(ctx context.Context, id string) (*User, *Response, error) {
+	for {
+		d.mu.Lock()
+		if u, ok := d.cached(id); ok {
+			d.mu.Unlock()
+			return u, nil, nil
+		}
+
+		c, ok := d.calls[id]
+		if !ok {
+			c = &userCall{done: make(chan struct{})}
+			d.calls[id] = c
+			d.mu.Unlock()
+
+			c.user, c.resp, c.err = d.client.Users.Get(ctx, id)
+
+			d.mu.Lock()
+			delete(d.calls, id)
+			if c.err == nil {
+				d.put(id, c.user)
+			}
+			d.mu.Unlock()
+			close(c.done)
+
+			return c.user, c.resp, c.err
+		}
+		d.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-c.done:
+		}
+
+		if isContextError(c.err) && ctx.Err() == nil {
+			continue
+		}
+
+		return c.user, nil, c.err
+	}
+}
+
+// isContextError reports whether err is the error of a canceled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Resolve gets the full user the reference is to, nil for a nil reference.
+func (d *UserDirectory) Resolve(ctx context.Context, u *MiniUser) (*User, error) {
+	if u == nil || u.ID == "" {
+		return nil, nil
+	}
+
+	return d.Get(ctx, u.ID)
+}
+
+// Prefetch fetches the users by User ID missing from the cache, a bounded number at the same time
+// waiting for the rate limit of the client. Every user is fetched even when some fail, the returned
+// error is the error of the first user failing in the order of the IDs.
+func (d *UserDirectory) Prefetch(ctx context.Context, ids ...string) error {
+	missing := []string{}
+	seen := map[string]bool{}
+
+	d.mu.Lock()
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if _, ok := d.cached(id); !ok {
+			missing = append(missing, id)
+		}
+	}
+	d.mu.Unlock()
+
+	errs := make([]error, len(missing))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.opt.Concurrency && w < len(missing); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				errs[i] = d.client.withRateLimit(ctx, func() (*Response, error) {
+					_, resp, err := d.get(ctx, missing[i])
+					return resp, err
+				})
+			}
+		}()
+	}
+
+	for i := range missing {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrefetchBoard fetches every user referenced in the board by Board ID: its owner, its members and
+// the users who created, modified or are assigned widgets.
+func (d *UserDirectory) PrefetchBoard(ctx context.Context, boardID string) error {
+	board, _, err := d.client.Boards.Get(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	members, _, err := d.client.BoardUserConnection.ListAllMembers(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	widgets, _, err := d.client.Widgets.List(ctx, boardID, "")
+	if err != nil {
+		return err
+	}
+
+	refs := []*MiniUser{board.Owner, board.CreatedBy, board.ModifiedBy}
+	for _, m := range members {
+		refs = append(refs, m.User)
+	}
+
+	ids := userIDs(refs)
+	for _, w := range widgets {
+		b := w.Base()
+		ids = append(ids, userIDs([]*MiniUser{b.CreatedBy, b.ModifiedBy})...)
+		if c, ok := w.(*Card); ok && c.Assignee != nil {
+			ids = append(ids, c.Assignee.UserID)
+		}
+	}
+
+	return d.Prefetch(ctx, ids...)
+}
+
+// PrefetchTeam fetches every user referenced in the team by Team ID: its members and the owners,
+// creators and last modifiers of the team and of its boards.
+func (d *UserDirectory) PrefetchTeam(ctx context.Context, teamID string) error {
+	team, _, err := d.client.Teams.Get(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	members, _, err := d.client.Teams.ListAllTeamMembers(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	boards, _, err := d.client.Boards.ListAllTeamBoards(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	refs := []*MiniUser{team.CreatedBy, team.ModifiedBy}
+	for _, m := range members {
+		refs = append(refs, m.User)
+	}
+	for _, b := range boards {
+		refs = append(refs, b.Owner, b.CreatedBy, b.ModifiedBy)
+	}
+
+	return d.Prefetch(ctx, userIDs(refs)...)
+}
+
+// Invalidate removes the user by User ID from the cache.
+func (d *UserDirectory) Invalidate(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if e, ok := d.entries[id]; ok {
+		d.lru.Remove(e)
+		delete(d.entries, id)
+	}
+}
+
+// Len returns the number of users cached, expired or not.
+func (d *UserDirectory) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.lru.Len()
+}
+
+// cached returns the user by User ID unless missing or expired, marking it as the most recently used.
+func (d *UserDirectory) cached(id string) (*User, bool) {
+	e, ok := d.entries[id]
+	if !ok {
+		return nil, false
+	}
+
+	entry := e.Value.(*userEntry)
+	if !d.now().Before(entry.expires) {
+		d.lru.Remove(e)
+		delete(d.entries, id)
+		return nil, false
+	}
+
+	d.lru.MoveToFront(e)
+	return entry.user, true
+}
+
+// put caches the user by User ID, evicting the least recently used users beyond the size of the cache.
+func (d *UserDirectory) put(id string, u *User) {
+	entry := &userEntry{id: id, user: u, expires: d.now().Add(d.opt.TTL)}
+	if e, ok := d.entries[id]; ok {
+		e.Value = entry
+		d.lru.MoveToFront(e)
+		return
+	}
+
+	d.entries[id] = d.lru.PushFront(entry)
+	for d.lru.Len() > d.opt.Size {
+		last := d.lru.Back()
+		d.lru.Remove(last)
+		delete(d.entries, last.Value.(*userEntry).id)
+	}
+}
+
+// userIDs returns the IDs of the references, sorted and without duplicates.
+func userIDs(refs []*MiniUser) []string {
+	seen := map[string]bool{}
+	ids := []string{}
+	for _, u := range refs {
+		if u == nil || u.ID == "" || seen[u.ID] {
+			continue
+		}
+		seen[u.ID] = true
+		ids = append(ids, u.ID)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
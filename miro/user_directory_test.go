@@ -0,0 +1,241 @@
+package miro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleUsers serves the users, failing the IDs starting with "fail", and returns the number of requests by User ID.
+func handleUsers(mux *http.ServeMux, release <-chan struct{}) func() map[string]int {
+	var mu sync.Mutex
+	requests := map[string]int{}
+
+	mux.HandleFunc(fmt.Sprintf("/%s/", usersPath), func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/%s/", usersPath))
+		mu.Lock()
+		requests[id]++
+		mu.Unlock()
+
+		if release != nil {
+			<-release
+		}
+
+		if strings.HasPrefix(id, "fail") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status": 404, "message": "not found"}`)
+			return
+		}
+
+		fmt.Fprint(w, getUserJSON(id))
+	})
+
+	return func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		got := map[string]int{}
+		for id, n := range requests {
+			got[id] = n
+		}
+		return got
+	}
+}
+
+func TestUserDirectory_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	requests := handleUsers(mux, nil)
+
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	d := NewUserDirectory(client, &UserDirectoryOptions{TTL: time.Minute, Size: 2})
+	d.now = func() time.Time { return now }
+
+	get := func(ids ...string) {
+		for _, id := range ids {
+			u, err := d.Get(context.Background(), id)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(u, getUser(id)); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		}
+	}
+
+	// b is the least recently used when c is cached.
+	get("a", "b", "a", "c", "a")
+	if diff := cmp.Diff(requests(), map[string]int{"a": 1, "b": 1, "c": 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	get("b")
+	if diff := cmp.Diff(requests(), map[string]int{"a": 1, "b": 2, "c": 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	now = now.Add(time.Minute)
+	get("b")
+	if diff := cmp.Diff(requests(), map[string]int{"a": 1, "b": 3, "c": 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	d.Invalidate("b")
+	if diff := cmp.Diff(d.Len(), 1); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if _, err := d.Get(context.Background(), "fail"); err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if u, err := d.Resolve(context.Background(), nil); u != nil || err != nil {
+		t.Fatalf("Should resolve nil, got:%v, %v", u, err)
+	}
+}
+
+func TestUserDirectory_Get_Concurrent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	requests := handleUsers(mux, release)
+	d := NewUserDirectory(client, nil)
+
+	var wg sync.WaitGroup
+	users := make([]*User, 10)
+	for i := range users {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			users[i], _ = d.Resolve(context.Background(), &MiniUser{ID: "a"})
+		}(i)
+	}
+
+	for requests()["a"] == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if diff := cmp.Diff(requests(), map[string]int{"a": 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	for _, u := range users {
+		if diff := cmp.Diff(u, getUser("a")); diff != "" {
+			t.Fatalf("Diff: %s(-got +want)", diff)
+		}
+	}
+}
+
+func TestUserDirectory_Get_Canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	requests := handleUsers(mux, release)
+	d := NewUserDirectory(client, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := d.Get(ctx, "a")
+		canceled <- err
+	}()
+
+	for requests()["a"] == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The waiting lookup sends the request again once the lookup that sent it gave up.
+	got := make(chan *User)
+	go func() {
+		u, err := d.Get(context.Background(), "a")
+		if err != nil {
+			t.Errorf("Failed: %v", err)
+		}
+		got <- u
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Should failed")
+	}
+	close(release)
+
+	if diff := cmp.Diff(<-got, getUser("a")); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(requests(), map[string]int{"a": 2}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUserDirectory_Prefetch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	requests := handleUsers(mux, nil)
+
+	d := NewUserDirectory(client, &UserDirectoryOptions{Concurrency: 2})
+	if _, err := d.Get(context.Background(), "a"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if err := d.Prefetch(context.Background(), "a", "b", "", "fail-1", "b", "c", "fail-2"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Should failed, got:%v", err)
+	}
+
+	if diff := cmp.Diff(requests(), map[string]int{"a": 1, "b": 1, "c": 1, "fail-1": 1, "fail-2": 1}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(d.Len(), 3); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUserDirectory_PrefetchBoard(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	requests := handleUsers(mux, nil)
+
+	mux.HandleFunc(fmt.Sprintf("/%s/board", boardsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getBoardJSON("board"))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, userConnectionsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"size": 2, "data": [{"id": "c1", "user": {"id": "alice"}}, {"id": "c2", "user": {"id": "owner"}}]}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type": "collection", "data": [
+	{"id": "s1", "type": "sticker", "createdBy": {"id": "bob"}, "modifiedBy": {"id": "alice"}},
+	{"id": "c1", "type": "card", "assignee": {"userId": "carol"}}
+]}`)
+	})
+
+	d := NewUserDirectory(client, nil)
+	if err := d.PrefetchBoard(context.Background(), "board"); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got := []string{}
+	for id := range requests() {
+		got = append(got, id)
+	}
+	sort.Strings(got)
+
+	if diff := cmp.Diff(got, []string{"alice", "bob", "carol", "editor", "owner"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
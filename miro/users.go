@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 )
 
@@ -21,6 +20,7 @@ type UsersService service
 // User object represents Miro User.
 //
 // API doc: https://developers.miro.com/reference#user-object
+//
 //go:generate gomodifytags -file $GOFILE -struct User -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct User -add-tags json -w -transform camelcase
 type User struct {
@@ -33,66 +33,47 @@ type User struct {
 	State     string       `json:"state"`
 	CreatedAt time.Time    `json:"createdAt"`
 	Picture   *MiniPicture `json:"picture"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // MiniUser is omitted user.
+//
 //go:generate gomodifytags -file $GOFILE -struct MiniUser -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct MiniUser -add-tags json -w -transform camelcase
 type MiniUser struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Get gets user by User ID.
 //
 // API doc: https://developers.miro.com/reference#get-user
-func (s *UsersService) Get(ctx context.Context, id string) (*User, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", usersPath, id))
-	if err != nil {
-		return nil, err
-	}
+func (s *UsersService) Get(ctx context.Context, id string) (*User, *Response, error) {
+	ctx = withMethod(ctx, "UsersService.Get")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s", usersPath, id))
 	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
-	}
-
-	user := &User{}
-	if err := json.NewDecoder(resp.Body).Decode(user); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return user, nil
+	return do[User](ctx, s.client, req, http.StatusOK)
 }
 
 // GetCurrentUser gets current user
 //
 // API doc: https://developers.miro.com/reference#get-current-user
-func (s *UsersService) GetCurrentUser(ctx context.Context) (*User, error) {
-	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/me", usersPath))
-	if err != nil {
-		return nil, err
-	}
+func (s *UsersService) GetCurrentUser(ctx context.Context) (*User, *Response, error) {
+	ctx = withMethod(ctx, "UsersService.GetCurrentUser")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/me", usersPath))
 	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+		return nil, nil, err
 	}
 
-	user := &User{}
-	if err := json.NewDecoder(resp.Body).Decode(user); err != nil {
-		return nil, err
-	}
-
-	return user, nil
+	return do[User](ctx, s.client, req, http.StatusOK)
 }
 
 // UpdateCurrentUserRequest represents the request to update current user
@@ -103,92 +84,49 @@ type UpdateCurrentUserRequest struct {
 // UpdateCurrentUser updates current user
 //
 // API doc: https://developers.miro.com/reference#update-current-user
-func (s *UsersService) UpdateCurrentUser(ctx context.Context, request *UpdateCurrentUserRequest) (*User, error) {
-	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/me", usersPath), request)
-	if err != nil {
-		return nil, err
-	}
+func (s *UsersService) UpdateCurrentUser(ctx context.Context, request *UpdateCurrentUserRequest) (*User, *Response, error) {
+	ctx = withMethod(ctx, "UsersService.UpdateCurrentUser")
 
-	resp, err := s.client.Do(ctx, req)
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/me", usersPath), request)
 	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status code not expected, got:%d", resp.StatusCode)
+		return nil, nil, err
 	}
 
-	user := &User{}
-	if err := json.NewDecoder(resp.Body).Decode(user); err != nil {
-		return nil, err
-	}
-
-	return user, nil
+	return do[User](ctx, s.client, req, http.StatusOK)
 }
 
-func (u *User) UnmarshalJSON(j []byte) error {
-	var rawStrings map[string]interface{}
-
-	err := json.Unmarshal(j, &rawStrings)
+// UnmarshalJSON decodes the user keeping the members unknown to this package in Extra.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type alias User
+	extra, err := unmarshalExtra(data, (*alias)(u))
 	if err != nil {
 		return err
 	}
 
-	for k, v := range rawStrings {
-		if strings.ToLower(k) == "id" {
-			u.ID = v.(string)
-		}
-
-		if strings.ToLower(k) == "name" {
-			u.Name = v.(string)
-		}
-
-		if strings.ToLower(k) == "role" {
-			u.Role = v.(string)
-		}
-
-		if strings.ToLower(k) == "email" {
-			u.Email = v.(string)
-		}
-
-		if strings.ToLower(k) == "company" {
-			u.Company = v.(string)
-		}
-
-		if strings.ToLower(k) == "industry" {
-			u.Industry = v.(string)
-		}
-
-		if strings.ToLower(k) == "state" {
-			u.State = v.(string)
-		}
-
-		if strings.ToLower(k) == "createdAt" {
-			at, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return err
-			}
-			u.CreatedAt = at
-		}
-
-		if strings.ToLower(k) == "picture" {
-			pic := &MiniPicture{}
-			p := v.(map[string]interface{})
-
-			for k, v := range p {
-				if strings.ToLower(k) == "id" {
-					pic.ID = v.(string)
-				}
-
-				if strings.ToLower(k) == "imageURL" {
-					pic.ImageURL = v.(string)
-				}
-			}
+	u.Extra = extra
+	return nil
+}
 
-			u.Picture = pic
-		}
+// MarshalJSON encodes the user with the members in Extra.
+func (u User) MarshalJSON() ([]byte, error) {
+	type alias User
+	return marshalExtra(alias(u), u.Extra)
+}
 
+// UnmarshalJSON decodes the mini user keeping the members unknown to this package in Extra.
+func (u *MiniUser) UnmarshalJSON(data []byte) error {
+	type alias MiniUser
+	extra, err := unmarshalExtra(data, (*alias)(u))
+	if err != nil {
+		return err
 	}
 
+	u.Extra = extra
 	return nil
 }
+
+// MarshalJSON encodes the mini user with the members in Extra.
+func (u MiniUser) MarshalJSON() ([]byte, error) {
+	type alias MiniUser
+	return marshalExtra(alias(u), u.Extra)
+}
@@ -33,7 +33,7 @@ func getUserJSON(id string) string {
 }
 
 func getUser(id string) *User {
-	createdAt, _ := time.Parse("1994-03-01T10:00:00Z", "1995-06-15T10:00:00Z")
+	createdAt, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
 
 	return &User{
 		ID:        id,
@@ -64,7 +64,7 @@ func TestUsersService_Get(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getUserJSON(tc.id)))
 			})
 
-			got, err := client.Users.Get(context.Background(), tc.id)
+			got, _, err := client.Users.Get(context.Background(), tc.id)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -92,7 +92,7 @@ func TestUsersService_GetCurrentUser(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getUserJSON("1")))
 			})
 
-			got, err := client.Users.GetCurrentUser(context.Background())
+			got, _, err := client.Users.GetCurrentUser(context.Background())
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -120,7 +120,7 @@ func TestUsersService_UpdateCurrentUser(t *testing.T) {
 				fmt.Fprint(w, fmt.Sprintf(getUserJSON("1")))
 			})
 
-			got, err := client.Users.GetCurrentUser(context.Background())
+			got, _, err := client.Users.GetCurrentUser(context.Background())
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
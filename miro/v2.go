@@ -0,0 +1,178 @@
+package miro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// V2 holds the services of Miro REST API v2.
+//
+// API doc: https://developers.miro.com/reference/api-reference
+type V2 struct {
+	Boards       BoardsV2API
+	Items        ItemsV2API
+	Connectors   ConnectorsV2API
+	Tags         TagsV2API
+	BoardMembers BoardMembersV2API
+}
+
+// PageV2 represents a page of a v2 list response with offset pagination.
+type PageV2[T any] struct {
+	Data   []T      `json:"data"`
+	Total  int      `json:"total"`
+	Size   int      `json:"size"`
+	Offset int      `json:"offset"`
+	Limit  int      `json:"limit"`
+	Links  *LinksV2 `json:"links,omitempty"`
+	Type   string   `json:"type,omitempty"`
+}
+
+// CursorPageV2 represents a page of a v2 list response with cursor pagination.
+type CursorPageV2[T any] struct {
+	Data   []T      `json:"data"`
+	Total  int      `json:"total"`
+	Size   int      `json:"size"`
+	Cursor string   `json:"cursor,omitempty"`
+	Limit  int      `json:"limit"`
+	Links  *LinksV2 `json:"links,omitempty"`
+	Type   string   `json:"type,omitempty"`
+}
+
+// The list responses of the API v2 named for the generated mocks, which do not support generic types.
+type (
+	ListBoardsV2Response            = PageV2[*BoardV2]
+	ListItemsV2Response             = CursorPageV2[*ItemV2]
+	ListConnectorsV2Response        = CursorPageV2[*ConnectorV2]
+	ListTagsV2Response              = PageV2[*TagV2]
+	ListBoardMembersV2Response      = PageV2[*BoardMemberV2]
+	ListTeamsResponse               = CursorPageV2[*Team]
+	ListOrganizationMembersResponse = CursorPageV2[*OrganizationMember]
+)
+
+// CursorOptions specifies the optional parameters to list APIs that support cursor pagination.
+type CursorOptions struct {
+	Limit  int
+	Cursor string
+}
+
+// LinksV2 represents the links of a v2 object or list response.
+type LinksV2 struct {
+	Self    string `json:"self,omitempty"`
+	Related string `json:"related,omitempty"`
+	Next    string `json:"next,omitempty"`
+	Prev    string `json:"prev,omitempty"`
+	First   string `json:"first,omitempty"`
+	Last    string `json:"last,omitempty"`
+}
+
+// PositionV2 represents the position of an item on a board.
+// Origin and RelativeTo default to the center of the item and the center of the board.
+type PositionV2 struct {
+	X          float64 `json:"x"`
+	Y          float64 `json:"y"`
+	Origin     string  `json:"origin,omitempty"`
+	RelativeTo string  `json:"relativeTo,omitempty"`
+}
+
+// GeometryV2 represents the size and rotation of an item.
+type GeometryV2 struct {
+	Width    float64 `json:"width,omitempty"`
+	Height   float64 `json:"height,omitempty"`
+	Rotation float64 `json:"rotation,omitempty"`
+}
+
+// ParentV2 represents the frame an item belongs to.
+type ParentV2 struct {
+	ID string `json:"id"`
+}
+
+// newV2Request creates an API v2 request.
+func (c *Client) newV2Request(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithVersion(APIVersion2, method, urlStr, body)
+}
+
+// addQuery adds the non-empty values in v as URL query parameters to path.
+func addQuery(path string, v url.Values) string {
+	for k, vs := range v {
+		if len(vs) == 0 || vs[0] == "" {
+			delete(v, k)
+		}
+	}
+
+	if len(v) == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?%s", path, v.Encode())
+}
+
+// addCursorOptions adds the parameters in opt as URL query parameters to v.
+func addCursorOptions(v url.Values, opt *CursorOptions) {
+	if opt == nil {
+		return
+	}
+
+	if opt.Limit > 0 {
+		v.Set("limit", strconv.Itoa(opt.Limit))
+	}
+
+	v.Set("cursor", opt.Cursor)
+}
+
+// listAllCursor lists every object by following the cursor of the pages returned by list.
+func listAllCursor[T any](ctx context.Context, list func(ctx context.Context, opt *CursorOptions) (*CursorPageV2[T], *Response, error)) ([]T, *Response, error) {
+	opt := &CursorOptions{}
+	all := []T{}
+
+	for {
+		page, resp, err := list(ctx, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		all = append(all, page.Data...)
+		if page.Cursor == "" || len(page.Data) == 0 {
+			return all, resp, nil
+		}
+
+		opt.Cursor = page.Cursor
+	}
+}
+
+// listAllOffset lists every object by following the offset of the pages returned by list.
+func listAllOffset[T any](ctx context.Context, list func(ctx context.Context, opt *ListOptions) (*PageV2[T], *Response, error)) ([]T, *Response, error) {
+	opt := &ListOptions{}
+	all := []T{}
+
+	for {
+		page, resp, err := list(ctx, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		all = append(all, page.Data...)
+		opt.Offset += len(page.Data)
+
+		if len(page.Data) == 0 || opt.Offset >= page.Total {
+			return all, resp, nil
+		}
+	}
+}
+
+// addListOptionsV2 adds the parameters in opt as URL query parameters to v.
+func addListOptionsV2(v url.Values, opt *ListOptions) {
+	if opt == nil {
+		return
+	}
+
+	if opt.Limit > 0 {
+		v.Set("limit", strconv.Itoa(opt.Limit))
+	}
+
+	if opt.Offset > 0 {
+		v.Set("offset", strconv.Itoa(opt.Offset))
+	}
+}
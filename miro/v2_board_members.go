@@ -0,0 +1,158 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	membersPath = "members"
+)
+
+// BoardMembersV2Service handles communication to Miro Board Members API v2.
+//
+// API doc: https://developers.miro.com/reference/get-board-members
+type BoardMembersV2Service service
+
+// BoardMemberV2 object represents a member of Miro Board in the API v2.
+//
+// API doc: https://developers.miro.com/reference/get-specific-board-member
+//
+//go:generate gomodifytags -file $GOFILE -struct BoardMemberV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct BoardMemberV2 -add-tags json -w -transform camelcase
+type BoardMemberV2 struct {
+	ID    string    `json:"id"`
+	Type  string    `json:"type"`
+	Name  string    `json:"name"`
+	Role  BoardRole `json:"role"`
+	Links *LinksV2  `json:"links,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ShareBoardV2Request represents share board request payload of the API v2.
+//
+//go:generate gomodifytags -file $GOFILE -struct ShareBoardV2Request -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ShareBoardV2Request -add-tags json -w -transform camelcase
+type ShareBoardV2Request struct {
+	Emails  []string  `json:"emails"`
+	Role    BoardRole `json:"role,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// ShareBoardV2Response represents share board response from Miro.
+//
+//go:generate gomodifytags -file $GOFILE -struct ShareBoardV2Response -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ShareBoardV2Response -add-tags json -w -transform camelcase
+type ShareBoardV2Response struct {
+	Successful []int64  `json:"successful"`
+	Failed     []string `json:"failed,omitempty"`
+}
+
+type updateBoardMemberV2Request struct {
+	Role BoardRole `json:"role"`
+}
+
+// List lists the members of the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/get-board-members
+func (s *BoardMembersV2Service) List(ctx context.Context, boardID string, opt *ListOptions) (*ListBoardMembersV2Response, *Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.List")
+
+	v := url.Values{}
+	addListOptionsV2(v, opt)
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, membersPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListBoardMembersV2Response](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAll lists every member of the board by following the pagination.
+func (s *BoardMembersV2Service) ListAll(ctx context.Context, boardID string) ([]*BoardMemberV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.ListAll")
+
+	return listAllOffset(ctx, func(ctx context.Context, opt *ListOptions) (*ListBoardMembersV2Response, *Response, error) {
+		return s.List(ctx, boardID, opt)
+	})
+}
+
+// Get gets the member of the board by Board ID and Member ID.
+//
+// API doc: https://developers.miro.com/reference/get-specific-board-member
+func (s *BoardMembersV2Service) Get(ctx context.Context, boardID, memberID string) (*BoardMemberV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, membersPath, memberID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardMemberV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Share shares the board by Board ID with the users by email.
+//
+// API doc: https://developers.miro.com/reference/share-board
+func (s *BoardMembersV2Service) Share(ctx context.Context, boardID string, body *ShareBoardV2Request) (*ShareBoardV2Response, *Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.Share")
+
+	req, err := s.client.newV2Request(http.MethodPost, fmt.Sprintf("%s/%s/%s", boardsPath, boardID, membersPath), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ShareBoardV2Response](ctx, s.client, req, http.StatusCreated)
+}
+
+// Update updates the role of the member of the board.
+//
+// API doc: https://developers.miro.com/reference/update-board-member
+func (s *BoardMembersV2Service) Update(ctx context.Context, boardID, memberID string, role BoardRole) (*BoardMemberV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.Update")
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, membersPath, memberID), &updateBoardMemberV2Request{Role: role})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardMemberV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Delete removes the member from the board.
+//
+// API doc: https://developers.miro.com/reference/remove-board-member
+func (s *BoardMembersV2Service) Delete(ctx context.Context, boardID, memberID string) (*Response, error) {
+	ctx = withMethod(ctx, "BoardMembersV2Service.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, membersPath, memberID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// UnmarshalJSON decodes the board member keeping the members unknown to this package in Extra.
+func (m *BoardMemberV2) UnmarshalJSON(data []byte) error {
+	type alias BoardMemberV2
+	extra, err := unmarshalExtra(data, (*alias)(m))
+	if err != nil {
+		return err
+	}
+
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the board member with the members in Extra.
+func (m BoardMemberV2) MarshalJSON() ([]byte, error) {
+	type alias BoardMemberV2
+	return marshalExtra(alias(m), m.Extra)
+}
@@ -0,0 +1,122 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBoardMembersV2Service_ListAll(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		want    []*BoardMemberV2
+	}{
+		"ok": {"board", []*BoardMemberV2{
+			{ID: "1", Type: "board_member", Name: "Owner", Role: BoardRoleOwner},
+			{ID: "2", Type: "board_member", Name: "Editor", Role: BoardRoleCoowner},
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, membersPath), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"data": [
+	{"id": "1", "type": "board_member", "name": "Owner", "role": "owner"},
+	{"id": "2", "type": "board_member", "name": "Editor", "role": "coowner"}
+], "total": 2, "offset": 0, "limit": 20}`)
+			})
+
+			got, _, err := client.V2.BoardMembers.ListAll(context.Background(), tc.boardID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardMembersV2Service_Update(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		memberID string
+		role     BoardRole
+		want     string
+	}{
+		"ok": {"1", BoardRoleCommenter, `{"role":"commenter"}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, membersPath, tc.memberID), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				var got json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(string(got), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				fmt.Fprintf(w, `{"id": "%s", "role": "%s"}`, tc.memberID, tc.role)
+			})
+
+			got, _, err := client.V2.BoardMembers.Update(context.Background(), "board", tc.memberID, tc.role)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, &BoardMemberV2{ID: tc.memberID, Role: tc.role}); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardMembersV2Service_Share(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		req  *ShareBoardV2Request
+		want *ShareBoardV2Response
+	}{
+		"ok": {
+			&ShareBoardV2Request{Emails: []string{"keke@miro.com", "bad"}, Role: BoardRoleEditor},
+			&ShareBoardV2Response{Successful: []int64{1}, Failed: []string{"bad"}},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, membersPath), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"successful": [1], "failed": ["bad"]}`)
+			})
+
+			got, _, err := client.V2.BoardMembers.Share(context.Background(), "board", tc.req)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BoardsV2Service handles communication to Miro Boards API v2.
+//
+// API doc: https://developers.miro.com/reference/get-boards
+type BoardsV2Service service
+
+// BoardV2 object represents Miro Board in the API v2.
+//
+// API doc: https://developers.miro.com/reference/get-specific-board
+//
+//go:generate gomodifytags -file $GOFILE -struct BoardV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct BoardV2 -add-tags json -w -transform camelcase
+type BoardV2 struct {
+	ID                string         `json:"id"`
+	Type              string         `json:"type"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	ModifiedAt        time.Time      `json:"modifiedAt"`
+	CreatedBy         *MiniUser      `json:"createdBy"`
+	ModifiedBy        *MiniUser      `json:"modifiedBy"`
+	Owner             *MiniUser      `json:"owner"`
+	Team              *MiniTeam      `json:"team"`
+	Picture           *MiniPicture   `json:"picture"`
+	Policy            *BoardPolicyV2 `json:"policy"`
+	CurrentUserMember *BoardMemberV2 `json:"currentUserMember"`
+	ViewLink          string         `json:"viewLink"`
+	Links             *LinksV2       `json:"links"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// BoardPolicyV2 object represents the permissions and sharing policies of the board.
+//
+//go:generate gomodifytags -file $GOFILE -struct BoardPolicyV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct BoardPolicyV2 -add-tags json -w -transform camelcase
+type BoardPolicyV2 struct {
+	PermissionsPolicy *PermissionsPolicyV2 `json:"permissionsPolicy,omitempty"`
+	SharingPolicy     *SharingPolicyV2     `json:"sharingPolicy,omitempty"`
+}
+
+// PermissionsPolicyV2 object represents who can use the collaboration tools, copy and share the board.
+//
+//go:generate gomodifytags -file $GOFILE -struct PermissionsPolicyV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct PermissionsPolicyV2 -add-tags json -w -transform camelcase
+type PermissionsPolicyV2 struct {
+	CollaborationToolsStartAccess string `json:"collaborationToolsStartAccess,omitempty"`
+	CopyAccess                    string `json:"copyAccess,omitempty"`
+	SharingAccess                 string `json:"sharingAccess,omitempty"`
+}
+
+// SharingPolicyV2 object represents the access levels of the board.
+//
+//go:generate gomodifytags -file $GOFILE -struct SharingPolicyV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct SharingPolicyV2 -add-tags json -w -transform camelcase
+type SharingPolicyV2 struct {
+	Access                            AccessLevel `json:"access,omitempty"`
+	InviteToAccountAndBoardLinkAccess AccessLevel `json:"inviteToAccountAndBoardLinkAccess,omitempty"`
+	OrganizationAccess                AccessLevel `json:"organizationAccess,omitempty"`
+	TeamAccess                        AccessLevel `json:"teamAccess,omitempty"`
+}
+
+// CreateBoardV2Request represents create and update board request payload of the API v2.
+//
+//go:generate gomodifytags -file $GOFILE -struct CreateBoardV2Request -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct CreateBoardV2Request -add-tags json -w -transform camelcase
+type CreateBoardV2Request struct {
+	Name        string         `json:"name,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Policy      *BoardPolicyV2 `json:"policy,omitempty"`
+	TeamID      string         `json:"teamId,omitempty"`
+}
+
+// ListBoardsV2Options specifies the optional parameters to BoardsV2Service.List.
+type ListBoardsV2Options struct {
+	TeamID string
+	Query  string
+	Owner  string
+	Sort   string
+
+	ListOptions
+}
+
+// List lists the boards accessible to the user.
+//
+// API doc: https://developers.miro.com/reference/get-boards
+func (s *BoardsV2Service) List(ctx context.Context, opt *ListBoardsV2Options) (*ListBoardsV2Response, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.List")
+
+	v := url.Values{}
+	if opt != nil {
+		v.Set("team_id", opt.TeamID)
+		v.Set("query", opt.Query)
+		v.Set("owner", opt.Owner)
+		v.Set("sort", opt.Sort)
+		addListOptionsV2(v, &opt.ListOptions)
+	}
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(boardsPath, v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListBoardsV2Response](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAll lists every board accessible to the user by following the pagination.
+func (s *BoardsV2Service) ListAll(ctx context.Context, opt *ListBoardsV2Options) ([]*BoardV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.ListAll")
+
+	o := ListBoardsV2Options{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return listAllOffset(ctx, func(ctx context.Context, lo *ListOptions) (*ListBoardsV2Response, *Response, error) {
+		o.ListOptions = ListOptions{Limit: o.Limit, Offset: lo.Offset}
+		return s.List(ctx, &o)
+	})
+}
+
+// Get gets board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/get-specific-board
+func (s *BoardsV2Service) Get(ctx context.Context, id string) (*BoardV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s", boardsPath, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates board.
+//
+// API doc: https://developers.miro.com/reference/create-board
+func (s *BoardsV2Service) Create(ctx context.Context, body *CreateBoardV2Request) (*BoardV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.Create")
+
+	req, err := s.client.newV2Request(http.MethodPost, boardsPath, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardV2](ctx, s.client, req, http.StatusCreated)
+}
+
+// Copy creates a copy of the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/copy-board
+func (s *BoardsV2Service) Copy(ctx context.Context, id string, body *CreateBoardV2Request) (*BoardV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.Copy")
+
+	req, err := s.client.newV2Request(http.MethodPut, addQuery(boardsPath, url.Values{"copy_from": {id}}), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardV2](ctx, s.client, req, http.StatusCreated)
+}
+
+// Update updates board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/update-board
+func (s *BoardsV2Service) Update(ctx context.Context, id string, body *CreateBoardV2Request) (*BoardV2, *Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.Update")
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s", boardsPath, id), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[BoardV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Delete deletes board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/delete-board
+func (s *BoardsV2Service) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = withMethod(ctx, "BoardsV2Service.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s", boardsPath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// UnmarshalJSON decodes the board keeping the members unknown to this package in Extra.
+func (b *BoardV2) UnmarshalJSON(data []byte) error {
+	type alias BoardV2
+	extra, err := unmarshalExtra(data, (*alias)(b))
+	if err != nil {
+		return err
+	}
+
+	b.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the board with the members in Extra.
+func (b BoardV2) MarshalJSON() ([]byte, error) {
+	type alias BoardV2
+	return marshalExtra(alias(b), b.Extra)
+}
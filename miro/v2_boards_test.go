@@ -0,0 +1,246 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getBoardV2JSON(id string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "board",
+	"name": "%s",
+	"description": "%s",
+	"viewLink": "%s",
+	"policy": {
+		"permissionsPolicy": {
+			"collaborationToolsStartAccess": "all_editors",
+			"copyAccess": "anyone",
+			"sharingAccess": "team_members_with_editing_rights"
+		},
+		"sharingPolicy": {
+			"access": "private",
+			"inviteToAccountAndBoardLinkAccess": "no_access",
+			"organizationAccess": "private",
+			"teamAccess": "edit"
+		}
+	},
+	"team": {
+		"id": "team",
+		"name": "Miro"
+	},
+	"owner": {
+		"id": "owner",
+		"name": "Owner"
+	},
+	"currentUserMember": {
+		"id": "owner",
+		"name": "Owner",
+		"role": "owner"
+	},
+	"createdAt": "1995-06-15T10:00:00Z",
+	"modifiedAt": "1995-06-15T10:00:00Z",
+	"project": {"id": "project"}
+}`, id, testBoardName, testBoardDesc, testBoardViewLink)
+}
+
+func getBoardV2(id string) *BoardV2 {
+	at, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
+
+	return &BoardV2{
+		ID:          id,
+		Type:        "board",
+		Name:        testBoardName,
+		Description: testBoardDesc,
+		ViewLink:    testBoardViewLink,
+		Policy: &BoardPolicyV2{
+			PermissionsPolicy: &PermissionsPolicyV2{
+				CollaborationToolsStartAccess: "all_editors",
+				CopyAccess:                    "anyone",
+				SharingAccess:                 "team_members_with_editing_rights",
+			},
+			SharingPolicy: &SharingPolicyV2{
+				Access:                            AccessLevelPrivate,
+				InviteToAccountAndBoardLinkAccess: "no_access",
+				OrganizationAccess:                AccessLevelPrivate,
+				TeamAccess:                        AccessLevelEdit,
+			},
+		},
+		Team:              &MiniTeam{ID: "team", Name: "Miro"},
+		Owner:             &MiniUser{ID: "owner", Name: "Owner"},
+		CurrentUserMember: &BoardMemberV2{ID: "owner", Name: "Owner", Role: BoardRoleOwner},
+		CreatedAt:         at,
+		ModifiedAt:        at,
+		Extra:             extra("project", `{"id": "project"}`),
+	}
+}
+
+func TestBoardsV2Service_Get(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id   string
+		want *BoardV2
+	}{
+		"ok": {"1", getBoardV2("1")},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", boardsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, getBoardV2JSON(tc.id))
+			})
+
+			got, _, err := client.V2.Boards.Get(context.Background(), tc.id)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardsV2Service_Create(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		req  *CreateBoardV2Request
+		want string
+	}{
+		"ok": {
+			&CreateBoardV2Request{
+				Name:   testBoardName,
+				TeamID: "team",
+				Policy: &BoardPolicyV2{SharingPolicy: &SharingPolicyV2{Access: AccessLevelView}},
+			},
+			`{"name":"test-name","policy":{"sharingPolicy":{"access":"view"}},"teamId":"team"}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc("/"+boardsPath, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				var got json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(string(got), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, getBoardV2JSON("1"))
+			})
+
+			got, _, err := client.V2.Boards.Create(context.Background(), tc.req)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, getBoardV2("1")); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardsV2Service_ListAll(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		opt         *ListBoardsV2Options
+		total       int
+		wantQueries []string
+	}{
+		"ok": {
+			&ListBoardsV2Options{TeamID: "team", ListOptions: ListOptions{Limit: 2}},
+			3,
+			[]string{"limit=2&team_id=team", "limit=2&offset=2&team_id=team"},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			queries := []string{}
+			mux.HandleFunc("/"+boardsPath, func(w http.ResponseWriter, r *http.Request) {
+				queries = append(queries, r.URL.RawQuery)
+				if len(queries) == 1 {
+					fmt.Fprintf(w, `{"data": [%s, %s], "total": %d, "offset": 0, "limit": 2}`, getBoardV2JSON("1"), getBoardV2JSON("2"), tc.total)
+					return
+				}
+
+				fmt.Fprintf(w, `{"data": [%s], "total": %d, "offset": 2, "limit": 2}`, getBoardV2JSON("3"), tc.total)
+			})
+
+			got, _, err := client.V2.Boards.ListAll(context.Background(), tc.opt)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, []*BoardV2{getBoardV2("1"), getBoardV2("2"), getBoardV2("3")}); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(queries, tc.wantQueries); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestBoardsV2Service_Delete(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id     string
+		status int
+		want   string
+	}{
+		"ok":        {"1", http.StatusNoContent, ""},
+		"not found": {"2", http.StatusNotFound, "status code not expected, got:404, message:error"},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", boardsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				if tc.status != http.StatusNoContent {
+					http.Error(w, getErrorJSON(tc.status), tc.status)
+					return
+				}
+				w.WriteHeader(tc.status)
+			})
+
+			_, err := client.V2.Boards.Delete(context.Background(), tc.id)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	connectorsPath = "connectors"
+)
+
+// ConnectorsV2Service handles communication to Miro Connectors API v2.
+//
+// API doc: https://developers.miro.com/reference/get-connectors
+type ConnectorsV2Service service
+
+// ConnectorShapeV2 represents the path of a connector.
+type ConnectorShapeV2 string
+
+const (
+	ConnectorShapeStraight ConnectorShapeV2 = "straight"
+	ConnectorShapeElbowed  ConnectorShapeV2 = "elbowed"
+	ConnectorShapeCurved   ConnectorShapeV2 = "curved"
+)
+
+// ConnectorV2 object represents a connector between two items on Miro Board.
+//
+// API doc: https://developers.miro.com/reference/get-connector
+//
+//go:generate gomodifytags -file $GOFILE -struct ConnectorV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ConnectorV2 -add-tags json -w -transform camelcase
+type ConnectorV2 struct {
+	ID         string            `json:"id"`
+	Type       string            `json:"type"`
+	Shape      ConnectorShapeV2  `json:"shape"`
+	StartItem  *ConnectorEndV2   `json:"startItem"`
+	EndItem    *ConnectorEndV2   `json:"endItem"`
+	Captions   []*CaptionV2      `json:"captions"`
+	Style      *ConnectorStyleV2 `json:"style"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	ModifiedAt time.Time         `json:"modifiedAt"`
+	CreatedBy  *MiniUser         `json:"createdBy"`
+	ModifiedBy *MiniUser         `json:"modifiedBy"`
+	Links      *LinksV2          `json:"links"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ConnectorEndV2 represents the item at an end of a connector.
+// Position is relative to the item, such as "50%", and SnapTo is one of auto, top, right, bottom and left.
+type ConnectorEndV2 struct {
+	ID       string              `json:"id"`
+	Position *RelativePositionV2 `json:"position,omitempty"`
+	SnapTo   string              `json:"snapTo,omitempty"`
+}
+
+// RelativePositionV2 represents a position relative to the size of an item in percent.
+type RelativePositionV2 struct {
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// CaptionV2 represents a caption of a connector.
+type CaptionV2 struct {
+	Content           string `json:"content"`
+	Position          string `json:"position,omitempty"`
+	TextAlignVertical string `json:"textAlignVertical,omitempty"`
+}
+
+// ConnectorStyleV2 represents the style of a connector.
+type ConnectorStyleV2 struct {
+	Color           string `json:"color,omitempty"`
+	EndStrokeCap    string `json:"endStrokeCap,omitempty"`
+	FontSize        string `json:"fontSize,omitempty"`
+	StartStrokeCap  string `json:"startStrokeCap,omitempty"`
+	StrokeColor     string `json:"strokeColor,omitempty"`
+	StrokeStyle     string `json:"strokeStyle,omitempty"`
+	StrokeWidth     string `json:"strokeWidth,omitempty"`
+	TextOrientation string `json:"textOrientation,omitempty"`
+}
+
+// ConnectorV2Request represents create and update connector request payload.
+//
+//go:generate gomodifytags -file $GOFILE -struct ConnectorV2Request -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ConnectorV2Request -add-tags json -w -transform camelcase
+type ConnectorV2Request struct {
+	StartItem *ConnectorEndV2   `json:"startItem,omitempty"`
+	EndItem   *ConnectorEndV2   `json:"endItem,omitempty"`
+	Shape     ConnectorShapeV2  `json:"shape,omitempty"`
+	Captions  []*CaptionV2      `json:"captions,omitempty"`
+	Style     *ConnectorStyleV2 `json:"style,omitempty"`
+}
+
+// List lists the connectors on the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/get-connectors
+func (s *ConnectorsV2Service) List(ctx context.Context, boardID string, opt *CursorOptions) (*ListConnectorsV2Response, *Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.List")
+
+	v := url.Values{}
+	addCursorOptions(v, opt)
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, connectorsPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListConnectorsV2Response](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAll lists every connector on the board by following the cursor.
+func (s *ConnectorsV2Service) ListAll(ctx context.Context, boardID string) ([]*ConnectorV2, *Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.ListAll")
+
+	return listAllCursor(ctx, func(ctx context.Context, opt *CursorOptions) (*ListConnectorsV2Response, *Response, error) {
+		return s.List(ctx, boardID, opt)
+	})
+}
+
+// Get gets the connector on the board by Board ID and Connector ID.
+//
+// API doc: https://developers.miro.com/reference/get-connector
+func (s *ConnectorsV2Service) Get(ctx context.Context, boardID, connectorID string) (*ConnectorV2, *Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, connectorsPath, connectorID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ConnectorV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates a connector on the board.
+//
+// API doc: https://developers.miro.com/reference/create-connector
+func (s *ConnectorsV2Service) Create(ctx context.Context, boardID string, body *ConnectorV2Request) (*ConnectorV2, *Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.Create")
+
+	req, err := s.client.newV2Request(http.MethodPost, fmt.Sprintf("%s/%s/%s", boardsPath, boardID, connectorsPath), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ConnectorV2](ctx, s.client, req, http.StatusOK, http.StatusCreated)
+}
+
+// Update updates the connector on the board.
+//
+// API doc: https://developers.miro.com/reference/update-connector
+func (s *ConnectorsV2Service) Update(ctx context.Context, boardID, connectorID string, body *ConnectorV2Request) (*ConnectorV2, *Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.Update")
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, connectorsPath, connectorID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ConnectorV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Delete deletes the connector on the board.
+//
+// API doc: https://developers.miro.com/reference/delete-connector
+func (s *ConnectorsV2Service) Delete(ctx context.Context, boardID, connectorID string) (*Response, error) {
+	ctx = withMethod(ctx, "ConnectorsV2Service.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, connectorsPath, connectorID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// UnmarshalJSON decodes the connector keeping the members unknown to this package in Extra.
+func (c *ConnectorV2) UnmarshalJSON(data []byte) error {
+	type alias ConnectorV2
+	extra, err := unmarshalExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the connector with the members in Extra.
+func (c ConnectorV2) MarshalJSON() ([]byte, error) {
+	type alias ConnectorV2
+	return marshalExtra(alias(c), c.Extra)
+}
@@ -0,0 +1,109 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getConnectorV2JSON(id string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "connector",
+	"shape": "elbowed",
+	"startItem": {"id": "1"},
+	"endItem": {"id": "2", "position": {"x": "50%%", "y": "0%%"}},
+	"captions": [{"content": "depends on"}],
+	"style": {"endStrokeCap": "arrow", "strokeColor": "#000000"}
+}`, id)
+}
+
+func getConnectorV2(id string) *ConnectorV2 {
+	return &ConnectorV2{
+		ID:        id,
+		Type:      "connector",
+		Shape:     ConnectorShapeElbowed,
+		StartItem: &ConnectorEndV2{ID: "1"},
+		EndItem:   &ConnectorEndV2{ID: "2", Position: &RelativePositionV2{X: "50%", Y: "0%"}},
+		Captions:  []*CaptionV2{{Content: "depends on"}},
+		Style:     &ConnectorStyleV2{EndStrokeCap: "arrow", StrokeColor: "#000000"},
+	}
+}
+
+func TestConnectorsV2Service_Get(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id   string
+		want *ConnectorV2
+	}{
+		"ok": {"1", getConnectorV2("1")},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, connectorsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, getConnectorV2JSON(tc.id))
+			})
+
+			got, _, err := client.V2.Connectors.Get(context.Background(), "board", tc.id)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestConnectorsV2Service_Create(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		req  *ConnectorV2Request
+		want string
+	}{
+		"ok": {
+			&ConnectorV2Request{
+				StartItem: &ConnectorEndV2{ID: "1", SnapTo: "right"},
+				EndItem:   &ConnectorEndV2{ID: "2"},
+				Shape:     ConnectorShapeCurved,
+			},
+			`{"startItem":{"id":"1","snapTo":"right"},"endItem":{"id":"2"},"shape":"curved"}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, connectorsPath), func(w http.ResponseWriter, r *http.Request) {
+				var got json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(string(got), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				fmt.Fprint(w, getConnectorV2JSON("1"))
+			})
+
+			got, _, err := client.V2.Connectors.Create(context.Background(), "board", tc.req)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, getConnectorV2("1")); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	itemsPath = "items"
+)
+
+// ItemsV2Service handles communication to Miro Items API v2.
+//
+// API doc: https://developers.miro.com/reference/get-items
+type ItemsV2Service service
+
+// ItemTypeV2 represents the type of an item on a board.
+type ItemTypeV2 string
+
+const (
+	ItemTypeStickyNote ItemTypeV2 = "sticky_note"
+	ItemTypeShape      ItemTypeV2 = "shape"
+	ItemTypeText       ItemTypeV2 = "text"
+	ItemTypeCard       ItemTypeV2 = "card"
+	ItemTypeAppCard    ItemTypeV2 = "app_card"
+	ItemTypeImage      ItemTypeV2 = "image"
+	ItemTypeDocument   ItemTypeV2 = "document"
+	ItemTypeEmbed      ItemTypeV2 = "embed"
+	ItemTypeFrame      ItemTypeV2 = "frame"
+)
+
+// itemTypePaths maps the item types to the paths of their type specific endpoints.
+var itemTypePaths = map[ItemTypeV2]string{
+	ItemTypeStickyNote: "sticky_notes",
+	ItemTypeShape:      "shapes",
+	ItemTypeText:       "texts",
+	ItemTypeCard:       "cards",
+	ItemTypeAppCard:    "app_cards",
+	ItemTypeImage:      "images",
+	ItemTypeDocument:   "documents",
+	ItemTypeEmbed:      "embeds",
+	ItemTypeFrame:      "frames",
+}
+
+// ItemV2 object represents an item on Miro Board in the API v2.
+//
+// Data and Style depend on Type, use DecodeData and DecodeStyle to decode them
+// into the matching types such as StickyNoteData and StickyNoteStyle.
+//
+// API doc: https://developers.miro.com/reference/get-specific-item
+//
+//go:generate gomodifytags -file $GOFILE -struct ItemV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct ItemV2 -add-tags json -w -transform camelcase
+type ItemV2 struct {
+	ID         string          `json:"id"`
+	Type       ItemTypeV2      `json:"type"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Style      json.RawMessage `json:"style,omitempty"`
+	Position   *PositionV2     `json:"position"`
+	Geometry   *GeometryV2     `json:"geometry"`
+	Parent     *ParentV2       `json:"parent"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	ModifiedAt time.Time       `json:"modifiedAt"`
+	CreatedBy  *MiniUser       `json:"createdBy"`
+	ModifiedBy *MiniUser       `json:"modifiedBy"`
+	Links      *LinksV2        `json:"links"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DecodeData decodes the data of the item into v.
+func (i *ItemV2) DecodeData(v interface{}) error {
+	if len(i.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(i.Data, v)
+}
+
+// DecodeStyle decodes the style of the item into v.
+func (i *ItemV2) DecodeStyle(v interface{}) error {
+	if len(i.Style) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(i.Style, v)
+}
+
+// ItemV2Request represents create and update item request payload.
+// Data and Style take the types matching the item type such as StickyNoteData and StickyNoteStyle.
+type ItemV2Request struct {
+	Data     interface{} `json:"data,omitempty"`
+	Style    interface{} `json:"style,omitempty"`
+	Position *PositionV2 `json:"position,omitempty"`
+	Geometry *GeometryV2 `json:"geometry,omitempty"`
+	Parent   *ParentV2   `json:"parent,omitempty"`
+}
+
+// StickyNoteData represents the data of a sticky note.
+type StickyNoteData struct {
+	Content string `json:"content,omitempty"`
+	Shape   string `json:"shape,omitempty"`
+}
+
+// StickyNoteStyle represents the style of a sticky note.
+type StickyNoteStyle struct {
+	FillColor         string `json:"fillColor,omitempty"`
+	TextAlign         string `json:"textAlign,omitempty"`
+	TextAlignVertical string `json:"textAlignVertical,omitempty"`
+}
+
+// ShapeData represents the data of a shape.
+type ShapeData struct {
+	Content string `json:"content,omitempty"`
+	Shape   string `json:"shape,omitempty"`
+}
+
+// ShapeStyle represents the style of a shape.
+type ShapeStyle struct {
+	BorderColor       string `json:"borderColor,omitempty"`
+	BorderOpacity     string `json:"borderOpacity,omitempty"`
+	BorderStyle       string `json:"borderStyle,omitempty"`
+	BorderWidth       string `json:"borderWidth,omitempty"`
+	Color             string `json:"color,omitempty"`
+	FillColor         string `json:"fillColor,omitempty"`
+	FillOpacity       string `json:"fillOpacity,omitempty"`
+	FontFamily        string `json:"fontFamily,omitempty"`
+	FontSize          string `json:"fontSize,omitempty"`
+	TextAlign         string `json:"textAlign,omitempty"`
+	TextAlignVertical string `json:"textAlignVertical,omitempty"`
+}
+
+// TextData represents the data of a text.
+type TextData struct {
+	Content string `json:"content,omitempty"`
+}
+
+// TextStyle represents the style of a text.
+type TextStyle struct {
+	Color       string `json:"color,omitempty"`
+	FillColor   string `json:"fillColor,omitempty"`
+	FillOpacity string `json:"fillOpacity,omitempty"`
+	FontFamily  string `json:"fontFamily,omitempty"`
+	FontSize    string `json:"fontSize,omitempty"`
+	TextAlign   string `json:"textAlign,omitempty"`
+}
+
+// CardData represents the data of a card.
+type CardData struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	AssigneeID  string     `json:"assigneeId,omitempty"`
+}
+
+// CardStyle represents the style of a card.
+type CardStyle struct {
+	CardTheme string `json:"cardTheme,omitempty"`
+}
+
+// AppCardData represents the data of an app card.
+type AppCardData struct {
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	Fields      []*AppCardField `json:"fields,omitempty"`
+}
+
+// AppCardField represents a custom field of an app card.
+type AppCardField struct {
+	Value     string `json:"value,omitempty"`
+	Tooltip   string `json:"tooltip,omitempty"`
+	IconURL   string `json:"iconUrl,omitempty"`
+	IconShape string `json:"iconShape,omitempty"`
+	FillColor string `json:"fillColor,omitempty"`
+	TextColor string `json:"textColor,omitempty"`
+}
+
+// AppCardStyle represents the style of an app card.
+type AppCardStyle struct {
+	FillColor string `json:"fillColor,omitempty"`
+}
+
+// ImageData represents the data of an image.
+// URL is set to create the image while ImageURL is returned by Miro.
+type ImageData struct {
+	Title    string `json:"title,omitempty"`
+	URL      string `json:"url,omitempty"`
+	ImageURL string `json:"imageUrl,omitempty"`
+}
+
+// DocumentData represents the data of a document.
+// URL is set to create the document while DocumentURL is returned by Miro.
+type DocumentData struct {
+	Title       string `json:"title,omitempty"`
+	URL         string `json:"url,omitempty"`
+	DocumentURL string `json:"documentUrl,omitempty"`
+}
+
+// EmbedData represents the data of an embed.
+type EmbedData struct {
+	URL          string `json:"url,omitempty"`
+	Mode         string `json:"mode,omitempty"`
+	PreviewURL   string `json:"previewUrl,omitempty"`
+	ContentType  string `json:"contentType,omitempty"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+	HTML         string `json:"html,omitempty"`
+	ProviderName string `json:"providerName,omitempty"`
+	ProviderURL  string `json:"providerUrl,omitempty"`
+	AuthorName   string `json:"authorName,omitempty"`
+}
+
+// FrameData represents the data of a frame.
+type FrameData struct {
+	Title       string `json:"title,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Type        string `json:"type,omitempty"`
+	ShowContent bool   `json:"showContent,omitempty"`
+}
+
+// FrameStyle represents the style of a frame.
+type FrameStyle struct {
+	FillColor string `json:"fillColor,omitempty"`
+}
+
+// ListItemsV2Options specifies the optional parameters to ItemsV2Service.List.
+type ListItemsV2Options struct {
+	Type ItemTypeV2
+
+	CursorOptions
+}
+
+// List lists the items on the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/get-items
+func (s *ItemsV2Service) List(ctx context.Context, boardID string, opt *ListItemsV2Options) (*ListItemsV2Response, *Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.List")
+
+	v := url.Values{}
+	if opt != nil {
+		v.Set("type", string(opt.Type))
+		addCursorOptions(v, &opt.CursorOptions)
+	}
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, itemsPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListItemsV2Response](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAll lists every item on the board by following the cursor.
+func (s *ItemsV2Service) ListAll(ctx context.Context, boardID string, opt *ListItemsV2Options) ([]*ItemV2, *Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.ListAll")
+
+	o := ListItemsV2Options{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return listAllCursor(ctx, func(ctx context.Context, co *CursorOptions) (*ListItemsV2Response, *Response, error) {
+		o.CursorOptions = CursorOptions{Limit: o.Limit, Cursor: co.Cursor}
+		return s.List(ctx, boardID, &o)
+	})
+}
+
+// Get gets the item on the board by Board ID and Item ID.
+//
+// API doc: https://developers.miro.com/reference/get-specific-item
+func (s *ItemsV2Service) Get(ctx context.Context, boardID, itemID string) (*ItemV2, *Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, itemsPath, itemID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ItemV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates an item of the type on the board.
+//
+// API doc: https://developers.miro.com/reference/create-sticky-note-item
+func (s *ItemsV2Service) Create(ctx context.Context, boardID string, itemType ItemTypeV2, body *ItemV2Request) (*ItemV2, *Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.Create")
+
+	path, err := itemTypePath(itemType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newV2Request(http.MethodPost, fmt.Sprintf("%s/%s/%s", boardsPath, boardID, path), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ItemV2](ctx, s.client, req, http.StatusCreated)
+}
+
+// Update updates the item of the type on the board.
+// An empty type updates the position and the parent of any item.
+//
+// API doc: https://developers.miro.com/reference/update-item-position-or-parent
+func (s *ItemsV2Service) Update(ctx context.Context, boardID string, itemType ItemTypeV2, itemID string, body *ItemV2Request) (*ItemV2, *Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.Update")
+
+	path := itemsPath
+	if itemType != "" {
+		var err error
+		if path, err = itemTypePath(itemType); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, path, itemID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ItemV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Delete deletes the item on the board by Board ID and Item ID.
+//
+// API doc: https://developers.miro.com/reference/delete-item
+func (s *ItemsV2Service) Delete(ctx context.Context, boardID, itemID string) (*Response, error) {
+	ctx = withMethod(ctx, "ItemsV2Service.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, itemsPath, itemID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+func itemTypePath(itemType ItemTypeV2) (string, error) {
+	path, ok := itemTypePaths[itemType]
+	if !ok {
+		return "", fmt.Errorf("unknown item type: %q", itemType)
+	}
+
+	return path, nil
+}
+
+// UnmarshalJSON decodes the item keeping the members unknown to this package in Extra.
+func (i *ItemV2) UnmarshalJSON(data []byte) error {
+	type alias ItemV2
+	extra, err := unmarshalExtra(data, (*alias)(i))
+	if err != nil {
+		return err
+	}
+
+	i.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the item with the members in Extra.
+func (i ItemV2) MarshalJSON() ([]byte, error) {
+	type alias ItemV2
+	return marshalExtra(alias(i), i.Extra)
+}
@@ -0,0 +1,233 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getStickyNoteV2JSON(id string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "sticky_note",
+	"data": {"content": "hello", "shape": "square"},
+	"style": {"fillColor": "yellow", "textAlign": "center"},
+	"position": {"x": 10, "y": 20, "origin": "center"},
+	"geometry": {"width": 100, "height": 100},
+	"parent": {"id": "frame"}
+}`, id)
+}
+
+func getStickyNoteV2(id string) *ItemV2 {
+	return &ItemV2{
+		ID:       id,
+		Type:     ItemTypeStickyNote,
+		Data:     json.RawMessage(`{"content": "hello", "shape": "square"}`),
+		Style:    json.RawMessage(`{"fillColor": "yellow", "textAlign": "center"}`),
+		Position: &PositionV2{X: 10, Y: 20, Origin: "center"},
+		Geometry: &GeometryV2{Width: 100, Height: 100},
+		Parent:   &ParentV2{ID: "frame"},
+	}
+}
+
+func TestItemsV2Service_Get(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID   string
+		itemID    string
+		want      *ItemV2
+		wantData  *StickyNoteData
+		wantStyle *StickyNoteStyle
+	}{
+		"ok": {
+			"board", "1", getStickyNoteV2("1"),
+			&StickyNoteData{Content: "hello", Shape: "square"},
+			&StickyNoteStyle{FillColor: "yellow", TextAlign: "center"},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s/%s", boardsPath, tc.boardID, itemsPath, tc.itemID), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, getStickyNoteV2JSON(tc.itemID))
+			})
+
+			got, _, err := client.V2.Items.Get(context.Background(), tc.boardID, tc.itemID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			data := &StickyNoteData{}
+			if err := got.DecodeData(data); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(data, tc.wantData); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			style := &StickyNoteStyle{}
+			if err := got.DecodeStyle(style); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(style, tc.wantStyle); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestItemsV2Service_Create(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID  string
+		itemType ItemTypeV2
+		req      *ItemV2Request
+		path     string
+		wantBody string
+		wantErr  string
+	}{
+		"sticky note": {
+			"board", ItemTypeStickyNote,
+			&ItemV2Request{
+				Data:     &StickyNoteData{Content: "hello"},
+				Position: &PositionV2{X: 10, Y: 20},
+			},
+			"sticky_notes",
+			`{"data":{"content":"hello"},"position":{"x":10,"y":20}}`,
+			"",
+		},
+		"app card": {
+			"board", ItemTypeAppCard,
+			&ItemV2Request{
+				Data:  &AppCardData{Title: "card", Fields: []*AppCardField{{Value: "v"}}},
+				Style: &AppCardStyle{FillColor: "#2d9bf0"},
+			},
+			"app_cards",
+			`{"data":{"title":"card","fields":[{"value":"v"}]},"style":{"fillColor":"#2d9bf0"}}`,
+			"",
+		},
+		"unknown type": {"board", "widget", &ItemV2Request{}, "widgets", "", `unknown item type: "widget"`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, tc.path), func(w http.ResponseWriter, r *http.Request) {
+				var got json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(string(got), tc.wantBody); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, getStickyNoteV2JSON("1"))
+			})
+
+			_, _, err := client.V2.Items.Create(context.Background(), tc.boardID, tc.itemType, tc.req)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+
+			if diff := cmp.Diff(got, tc.wantErr); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestItemsV2Service_Update(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		itemType ItemTypeV2
+		itemID   string
+		path     string
+	}{
+		"typed":   {ItemTypeFrame, "1", "frames"},
+		"generic": {"", "2", itemsPath},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			called := false
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, tc.path, tc.itemID), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				called = true
+				fmt.Fprint(w, getStickyNoteV2JSON(tc.itemID))
+			})
+
+			_, _, err := client.V2.Items.Update(context.Background(), "board", tc.itemType, tc.itemID, &ItemV2Request{Position: &PositionV2{X: 1, Y: 2}})
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if !called {
+				t.Fatalf("endpoint not called")
+			}
+		})
+	}
+}
+
+func TestItemsV2Service_ListAll(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		opt         *ListItemsV2Options
+		wantQueries []string
+	}{
+		"ok": {
+			&ListItemsV2Options{Type: ItemTypeStickyNote, CursorOptions: CursorOptions{Limit: 10}},
+			[]string{"limit=10&type=sticky_note", "cursor=next&limit=10&type=sticky_note"},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			queries := []string{}
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, itemsPath), func(w http.ResponseWriter, r *http.Request) {
+				queries = append(queries, r.URL.RawQuery)
+				if r.URL.Query().Get("cursor") == "" {
+					fmt.Fprintf(w, `{"data": [%s], "total": 2, "size": 1, "limit": 10, "cursor": "next"}`, getStickyNoteV2JSON("1"))
+					return
+				}
+
+				fmt.Fprintf(w, `{"data": [%s], "total": 2, "size": 1, "limit": 10}`, getStickyNoteV2JSON("2"))
+			})
+
+			got, _, err := client.V2.Items.ListAll(context.Background(), "board", tc.opt)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, []*ItemV2{getStickyNoteV2("1"), getStickyNoteV2("2")}); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(queries, tc.wantQueries); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	tagsPath = "tags"
+)
+
+// TagsV2Service handles communication to Miro Tags API v2.
+//
+// API doc: https://developers.miro.com/reference/get-tags-from-board
+type TagsV2Service service
+
+// TagV2 object represents a tag on Miro Board in the API v2.
+//
+// API doc: https://developers.miro.com/reference/get-tag
+//
+//go:generate gomodifytags -file $GOFILE -struct TagV2 -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TagV2 -add-tags json -w -transform camelcase
+type TagV2 struct {
+	ID        string   `json:"id"`
+	Type      string   `json:"type"`
+	Title     string   `json:"title"`
+	FillColor string   `json:"fillColor"`
+	Links     *LinksV2 `json:"links,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TagV2Request represents create and update tag request payload.
+//
+//go:generate gomodifytags -file $GOFILE -struct TagV2Request -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct TagV2Request -add-tags json -w -transform camelcase
+type TagV2Request struct {
+	Title     string `json:"title,omitempty"`
+	FillColor string `json:"fillColor,omitempty"`
+}
+
+// itemTagsResponse represents the tags of an item.
+type itemTagsResponse struct {
+	Tags []*TagV2 `json:"tags"`
+}
+
+// List lists the tags on the board by Board ID.
+//
+// API doc: https://developers.miro.com/reference/get-tags-from-board
+func (s *TagsV2Service) List(ctx context.Context, boardID string, opt *ListOptions) (*ListTagsV2Response, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.List")
+
+	v := url.Values{}
+	addListOptionsV2(v, opt)
+
+	req, err := s.client.newV2Request(http.MethodGet, addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, tagsPath), v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[ListTagsV2Response](ctx, s.client, req, http.StatusOK)
+}
+
+// ListAll lists every tag on the board by following the pagination.
+func (s *TagsV2Service) ListAll(ctx context.Context, boardID string) ([]*TagV2, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.ListAll")
+
+	return listAllOffset(ctx, func(ctx context.Context, opt *ListOptions) (*ListTagsV2Response, *Response, error) {
+		return s.List(ctx, boardID, opt)
+	})
+}
+
+// Get gets the tag on the board by Board ID and Tag ID.
+//
+// API doc: https://developers.miro.com/reference/get-tag
+func (s *TagsV2Service) Get(ctx context.Context, boardID, tagID string) (*TagV2, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Get")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[TagV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates a tag on the board.
+//
+// API doc: https://developers.miro.com/reference/create-tag
+func (s *TagsV2Service) Create(ctx context.Context, boardID string, body *TagV2Request) (*TagV2, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Create")
+
+	req, err := s.client.newV2Request(http.MethodPost, fmt.Sprintf("%s/%s/%s", boardsPath, boardID, tagsPath), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[TagV2](ctx, s.client, req, http.StatusCreated)
+}
+
+// Update updates the tag on the board.
+//
+// API doc: https://developers.miro.com/reference/update-tag
+func (s *TagsV2Service) Update(ctx context.Context, boardID, tagID string, body *TagV2Request) (*TagV2, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Update")
+
+	req, err := s.client.newV2Request(http.MethodPatch, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return do[TagV2](ctx, s.client, req, http.StatusOK)
+}
+
+// Delete deletes the tag from the board and from every item it is attached to.
+//
+// API doc: https://developers.miro.com/reference/delete-tag
+func (s *TagsV2Service) Delete(ctx context.Context, boardID, tagID string) (*Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Delete")
+
+	req, err := s.client.newV2Request(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, tagsPath, tagID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// Attach attaches the tag to the item.
+//
+// API doc: https://developers.miro.com/reference/attach-tag-to-item
+func (s *TagsV2Service) Attach(ctx context.Context, boardID, itemID, tagID string) (*Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Attach")
+
+	path := addQuery(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, itemsPath, itemID), url.Values{"tag_id": {tagID}})
+	req, err := s.client.newV2Request(http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// Detach removes the tag from the item.
+//
+// API doc: https://developers.miro.com/reference/remove-tag-from-item
+func (s *TagsV2Service) Detach(ctx context.Context, boardID, itemID, tagID string) (*Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.Detach")
+
+	path := addQuery(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, itemsPath, itemID), url.Values{"tag_id": {tagID}})
+	req, err := s.client.newV2Request(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+// ListItemTags lists the tags attached to the item.
+//
+// API doc: https://developers.miro.com/reference/get-tags-from-item
+func (s *TagsV2Service) ListItemTags(ctx context.Context, boardID, itemID string) ([]*TagV2, *Response, error) {
+	ctx = withMethod(ctx, "TagsV2Service.ListItemTags")
+
+	req, err := s.client.newV2Request(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s/%s", boardsPath, boardID, itemsPath, itemID, tagsPath), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, resp, err := do[itemTagsResponse](ctx, s.client, req, http.StatusOK)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tags.Tags, resp, nil
+}
+
+// UnmarshalJSON decodes the tag keeping the members unknown to this package in Extra.
+func (t *TagV2) UnmarshalJSON(data []byte) error {
+	type alias TagV2
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the tag with the members in Extra.
+func (t TagV2) MarshalJSON() ([]byte, error) {
+	type alias TagV2
+	return marshalExtra(alias(t), t.Extra)
+}
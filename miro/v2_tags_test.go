@@ -0,0 +1,124 @@
+package miro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getTagV2JSON(id string) string {
+	return fmt.Sprintf(`{"id": "%s", "type": "tag", "title": "todo", "fillColor": "red"}`, id)
+}
+
+func getTagV2(id string) *TagV2 {
+	return &TagV2{ID: id, Type: "tag", Title: "todo", FillColor: "red"}
+}
+
+func TestTagsV2Service_ListAll(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		boardID string
+		want    []*TagV2
+	}{
+		"ok": {"board", []*TagV2{getTagV2("1"), getTagV2("2")}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, tc.boardID, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("offset") == "" {
+					fmt.Fprintf(w, `{"data": [%s], "total": 2, "offset": 0}`, getTagV2JSON("1"))
+					return
+				}
+
+				fmt.Fprintf(w, `{"data": [%s], "total": 2, "offset": 1}`, getTagV2JSON("2"))
+			})
+
+			got, _, err := client.V2.Tags.ListAll(context.Background(), tc.boardID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestTagsV2Service_Attach(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		itemID string
+		tagID  string
+		attach bool
+		want   string
+	}{
+		"attach": {"1", "tag", true, http.MethodPost},
+		"detach": {"2", "tag", false, http.MethodDelete},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := ""
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, itemsPath, tc.itemID), func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("tag_id") != tc.tagID {
+					t.Fatalf("tag not expected, got:%s", r.URL.RawQuery)
+				}
+
+				got = r.Method
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			var err error
+			if tc.attach {
+				_, err = client.V2.Tags.Attach(context.Background(), "board", tc.itemID, tc.tagID)
+			} else {
+				_, err = client.V2.Tags.Detach(context.Background(), "board", tc.itemID, tc.tagID)
+			}
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestTagsV2Service_ListItemTags(t *testing.T) {
+	client, mux, _, teardown := setupV2()
+	defer teardown()
+
+	tcs := map[string]struct {
+		itemID string
+		want   []*TagV2
+	}{
+		"ok": {"1", []*TagV2{getTagV2("tag")}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s/%s", boardsPath, itemsPath, tc.itemID, tagsPath), func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"id": "%s", "tags": [%s]}`, tc.itemID, getTagV2JSON("tag"))
+			})
+
+			got, _, err := client.V2.Tags.ListItemTags(context.Background(), "board", tc.itemID)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package watch
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+// ChangeType represents the kind of a change of a widget.
+type ChangeType string
+
+const (
+	// ChangeAdded is a widget added to the board.
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved is a widget removed from the board.
+	ChangeRemoved ChangeType = "removed"
+	// ChangeMoved is a widget whose position changed.
+	ChangeMoved ChangeType = "moved"
+	// ChangeResized is a widget whose width, height or scale changed.
+	ChangeResized ChangeType = "resized"
+	// ChangeTextEdited is a widget whose text, title, description or captions changed.
+	ChangeTextEdited ChangeType = "text_edited"
+	// ChangeStyled is a widget whose style changed.
+	ChangeStyled ChangeType = "styled"
+)
+
+// Change represents a change of a widget between two snapshots of a board.
+// A widget changed in several ways has a change of each type.
+type Change struct {
+	Type     ChangeType
+	WidgetID string
+
+	// Before is the widget in the older snapshot, nil when added.
+	Before miro.Widget
+
+	// After is the widget in the newer snapshot, nil when removed.
+	After miro.Widget
+
+	// DetectedAt is the time of the poll detecting the change, zero for Diff.
+	DetectedAt time.Time
+}
+
+// Diff returns the changes of the widgets between the snapshots, by Widget ID.
+// The changes of a widget are in the order of the ChangeType constants.
+func Diff(before, after []miro.Widget) []*Change {
+	old := make(map[string]miro.Widget, len(before))
+	for _, w := range before {
+		old[w.Base().ID] = w
+	}
+
+	ids := make([]string, 0, len(before)+len(after))
+	cur := make(map[string]miro.Widget, len(after))
+	for _, w := range after {
+		id := w.Base().ID
+		cur[id] = w
+		ids = append(ids, id)
+	}
+
+	for id := range old {
+		if _, ok := cur[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var changes []*Change
+	for _, id := range ids {
+		b, a := old[id], cur[id]
+		switch {
+		case b == nil:
+			changes = append(changes, &Change{Type: ChangeAdded, WidgetID: id, After: a})
+		case a == nil:
+			changes = append(changes, &Change{Type: ChangeRemoved, WidgetID: id, Before: b})
+		default:
+			for _, t := range diffWidget(b, a) {
+				changes = append(changes, &Change{Type: t, WidgetID: id, Before: b, After: a})
+			}
+		}
+	}
+
+	return changes
+}
+
+// diffWidget returns the types of the changes between two versions of a widget.
+func diffWidget(before, after miro.Widget) []ChangeType {
+	var types []ChangeType
+	b, a := before.Base(), after.Base()
+
+	if b.X != a.X || b.Y != a.Y || !reflect.DeepEqual(ends(before), ends(after)) {
+		types = append(types, ChangeMoved)
+	}
+
+	if b.Width != a.Width || b.Height != a.Height || b.Scale != a.Scale {
+		types = append(types, ChangeResized)
+	}
+
+	if !reflect.DeepEqual(texts(before), texts(after)) {
+		types = append(types, ChangeTextEdited)
+	}
+
+	if !reflect.DeepEqual(style(before), style(after)) {
+		types = append(types, ChangeStyled)
+	}
+
+	return types
+}
+
+// ends returns the ends of lines, which move without their center.
+func ends(w miro.Widget) []interface{} {
+	l, ok := w.(*miro.Line)
+	if !ok {
+		return nil
+	}
+
+	return []interface{}{l.StartWidget, l.EndWidget, l.StartPosition, l.EndPosition}
+}
+
+// texts returns the texts of the widget, as formatted by Miro.
+func texts(w miro.Widget) []string {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return []string{w.Text}
+	case *miro.Shape:
+		return []string{w.Text}
+	case *miro.Text:
+		return []string{w.Text}
+	case *miro.Card:
+		return []string{w.Title, w.Description}
+	case *miro.Frame:
+		return []string{w.Title}
+	case *miro.Line:
+		texts := make([]string, 0, len(w.Captions))
+		for _, c := range w.Captions {
+			texts = append(texts, c.Text)
+		}
+		return texts
+	}
+
+	return nil
+}
+
+// style returns the style of the widget, nil if its type has none.
+func style(w miro.Widget) interface{} {
+	switch w := w.(type) {
+	case *miro.Sticker:
+		return w.Style
+	case *miro.Shape:
+		return w.Style
+	case *miro.Text:
+		return w.Style
+	case *miro.Card:
+		return w.Style
+	case *miro.Frame:
+		return w.Style
+	case *miro.Line:
+		return w.Style
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/google/go-cmp/cmp"
+)
+
+func sticker(id string, x, y float64, text, color string) *miro.Sticker {
+	return &miro.Sticker{
+		WidgetBase: miro.WidgetBase{ID: id, Type: miro.WidgetTypeSticker, X: x, Y: y, Width: 199, Height: 228},
+		Text:       text,
+		Style:      &miro.WidgetStyle{BackgroundColor: color},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	s1 := sticker("s1", 0, 0, "<p>Idea</p>", "#fff9b1")
+
+	resized := sticker("s1", 0, 0, "<p>Idea</p>", "#fff9b1")
+	resized.Scale = 2
+
+	tcs := map[string]struct {
+		before []miro.Widget
+		after  []miro.Widget
+		want   []ChangeType
+	}{
+		"same": {
+			before: []miro.Widget{s1},
+			after:  []miro.Widget{sticker("s1", 0, 0, "<p>Idea</p>", "#fff9b1")},
+		},
+		"added": {
+			after: []miro.Widget{s1},
+			want:  []ChangeType{ChangeAdded},
+		},
+		"removed": {
+			before: []miro.Widget{s1},
+			want:   []ChangeType{ChangeRemoved},
+		},
+		"moved": {
+			before: []miro.Widget{s1},
+			after:  []miro.Widget{sticker("s1", 10, 0, "<p>Idea</p>", "#fff9b1")},
+			want:   []ChangeType{ChangeMoved},
+		},
+		"resized": {
+			before: []miro.Widget{s1},
+			after:  []miro.Widget{resized},
+			want:   []ChangeType{ChangeResized},
+		},
+		"text edited and styled": {
+			before: []miro.Widget{s1},
+			after:  []miro.Widget{sticker("s1", 0, 0, "<p>Better idea</p>", "#f24726")},
+			want:   []ChangeType{ChangeTextEdited, ChangeStyled},
+		},
+		"line end moved": {
+			before: []miro.Widget{&miro.Line{WidgetBase: miro.WidgetBase{ID: "l1"}, EndPosition: &miro.Point{X: 1, Y: 1}}},
+			after:  []miro.Widget{&miro.Line{WidgetBase: miro.WidgetBase{ID: "l1"}, EndPosition: &miro.Point{X: 2, Y: 1}}},
+			want:   []ChangeType{ChangeMoved},
+		},
+		"card description": {
+			before: []miro.Widget{&miro.Card{WidgetBase: miro.WidgetBase{ID: "c1"}, Title: "Task"}},
+			after:  []miro.Widget{&miro.Card{WidgetBase: miro.WidgetBase{ID: "c1"}, Title: "Task", Description: "Details"}},
+			want:   []ChangeType{ChangeTextEdited},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			var got []ChangeType
+			for _, c := range Diff(tc.before, tc.after) {
+				got = append(got, c.Type)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestDiff_Order(t *testing.T) {
+	before := []miro.Widget{sticker("b", 0, 0, "", ""), sticker("c", 0, 0, "", "")}
+	after := []miro.Widget{sticker("c", 5, 5, "", ""), sticker("a", 0, 0, "", "")}
+
+	var got []string
+	for _, c := range Diff(before, after) {
+		got = append(got, c.WidgetID+" "+string(c.Type))
+	}
+
+	if diff := cmp.Diff(got, []string{"a added", "b removed", "c moved"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
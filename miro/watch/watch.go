@@ -0,0 +1,194 @@
+// Package watch detects the changes of the widgets of a Miro board by polling.
+//
+// A Watcher lists the widgets of the board periodically and sends the changes
+// between two polls, computed by Diff, on a channel. It polls more often while
+// the board changes and less often while it is quiet, and never faster than the
+// rate limit of the client allows.
+package watch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+)
+
+const (
+	defaultMinInterval = 5 * time.Second
+	defaultMaxInterval = time.Minute
+	defaultBudget      = 0.1
+)
+
+// Options specifies the parameters of a Watcher.
+type Options struct {
+	// WidgetType watches only the widgets of the type. Empty watches every widget.
+	WidgetType miro.WidgetType
+
+	// MinInterval is the interval between polls while the board changes, 5 seconds by default.
+	MinInterval time.Duration
+
+	// MaxInterval is the interval between polls the interval doubles up to while the board
+	// does not change, 1 minute by default.
+	MaxInterval time.Duration
+
+	// Budget is the share of the requests remaining in the rate limit the watcher may use
+	// before its reset, 0.1 by default, leaving the others to the other requests of the client.
+	Budget float64
+}
+
+// Watcher polls the widgets of a board for changes.
+type Watcher struct {
+	client  *miro.Client
+	boardID string
+	opt     Options
+
+	mu       sync.Mutex
+	widgets  []miro.Widget
+	polled   bool
+	interval time.Duration
+}
+
+// NewWatcher returns a new watcher of the board.
+func NewWatcher(client *miro.Client, boardID string, opt Options) *Watcher {
+	if opt.MinInterval <= 0 {
+		opt.MinInterval = defaultMinInterval
+	}
+
+	if opt.MaxInterval < opt.MinInterval {
+		opt.MaxInterval = defaultMaxInterval
+		if opt.MaxInterval < opt.MinInterval {
+			opt.MaxInterval = opt.MinInterval
+		}
+	}
+
+	if opt.Budget <= 0 || opt.Budget > 1 {
+		opt.Budget = defaultBudget
+	}
+
+	return &Watcher{
+		client:   client,
+		boardID:  boardID,
+		opt:      opt,
+		interval: opt.MinInterval,
+	}
+}
+
+// Widgets returns the widgets of the last poll.
+func (w *Watcher) Widgets() []miro.Widget {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]miro.Widget{}, w.widgets...)
+}
+
+// Poll lists the widgets of the board and returns their changes since the last poll.
+// The first poll takes the snapshot the next ones are compared to, and returns no changes.
+func (w *Watcher) Poll(ctx context.Context) ([]*Change, *miro.Response, error) {
+	widgets, resp, err := w.client.Widgets.List(ctx, w.boardID, w.opt.WidgetType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var changes []*Change
+	if w.polled {
+		now := time.Now()
+		changes = Diff(w.widgets, widgets)
+		for _, c := range changes {
+			c.DetectedAt = now
+		}
+	}
+
+	w.widgets = widgets
+	w.polled = true
+	return changes, resp, nil
+}
+
+// Run polls the board and sends the changes on the channel until the context is done.
+// Polls rejected with 429 Too Many Requests are retried after the reset of the rate limit,
+// and Run returns the other errors, so that it can be called again to resume from the last poll.
+func (w *Watcher) Run(ctx context.Context, changes chan<- *Change) error {
+	for {
+		cs, resp, err := w.Poll(ctx)
+		var rate miro.RateLimit
+		if resp != nil {
+			rate = resp.RateLimit
+		}
+
+		var respErr *miro.RespError
+		if err != nil && (!errors.As(err, &respErr) || respErr.Status != http.StatusTooManyRequests) {
+			return err
+		}
+
+		for _, c := range cs {
+			select {
+			case changes <- c:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		var d time.Duration
+		if err != nil {
+			// Rate limited, wait for the reset without changing the interval.
+			d = time.Until(rate.Reset)
+			if d <= 0 {
+				d = w.opt.MaxInterval
+			}
+		} else {
+			w.mu.Lock()
+			w.interval = w.next(w.interval, len(cs) > 0, rate, time.Now())
+			d = w.interval
+			w.mu.Unlock()
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// next returns the interval until the next poll.
+// It is the minimum interval after changes, and doubles up to the maximum interval otherwise.
+// It is then lengthened to spread the budget of the remaining requests until the reset of the rate limit.
+func (w *Watcher) next(interval time.Duration, changed bool, rate miro.RateLimit, now time.Time) time.Duration {
+	d := interval * 2
+	if changed {
+		d = w.opt.MinInterval
+	}
+	if d > w.opt.MaxInterval {
+		d = w.opt.MaxInterval
+	}
+	if d < w.opt.MinInterval {
+		d = w.opt.MinInterval
+	}
+
+	reset := rate.Reset.Sub(now)
+	if rate.Reset.IsZero() || reset <= 0 {
+		return d
+	}
+
+	budget := int(float64(rate.Remaining) * w.opt.Budget)
+	if budget < 1 {
+		return maxDuration(d, reset)
+	}
+
+	return maxDuration(d, reset/time.Duration(budget))
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,189 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Miro-Ecosystem/go-miro/miro"
+	"github.com/Miro-Ecosystem/go-miro/miro/internal/mirotest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// polls serves the lists of widgets in order, then the last one.
+func polls(lists ...string) http.HandlerFunc {
+	var mu sync.Mutex
+	n := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintf(w, `{"type": "collection", "data": [%s]}`, lists[n])
+		if n < len(lists)-1 {
+			n++
+		}
+	}
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	mux.HandleFunc("/boards/board/widgets", polls(
+		`{"id": "s1", "type": "sticker", "x": 0, "y": 0, "text": "Idea"}`,
+		`{"id": "s1", "type": "sticker", "x": 10, "y": 0, "text": "Idea"}, {"id": "s2", "type": "sticker", "text": "New"}`,
+	))
+
+	w := NewWatcher(client, "board", Options{})
+	ctx := context.Background()
+
+	got, _, err := w.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Fatalf("Should not report changes on the first poll, got:%v", got)
+	}
+
+	got, _, err = w.Poll(ctx)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var types []string
+	for _, c := range got {
+		if c.DetectedAt.IsZero() {
+			t.Fatalf("Should set DetectedAt")
+		}
+		types = append(types, c.WidgetID+" "+string(c.Type))
+	}
+
+	if diff := cmp.Diff(types, []string{"s1 moved", "s2 added"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if diff := cmp.Diff(len(w.Widgets()), 2); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	mux.HandleFunc("/boards/board/widgets", polls(
+		`{"id": "s1", "type": "sticker", "text": "Idea"}`,
+		`{"id": "s1", "type": "sticker", "text": "Idea"}`,
+		`{"id": "s1", "type": "sticker", "text": "Better idea"}`,
+		``,
+	))
+
+	w := NewWatcher(client, "board", Options{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan *Change)
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx, changes)
+	}()
+
+	var got []ChangeType
+	for len(got) < 2 {
+		select {
+		case c := <-changes:
+			got = append(got, c.Type)
+		case err := <-done:
+			t.Fatalf("Failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Should report changes")
+		}
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Should return the context error, got:%v", err)
+	}
+
+	if diff := cmp.Diff(got, []ChangeType{ChangeTextEdited, ChangeRemoved}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWatcher_Run_Error(t *testing.T) {
+	client, mux, teardown := mirotest.Setup()
+	defer teardown()
+
+	mux.HandleFunc("/boards/board/widgets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": 404, "message": "board not found"}`)
+	})
+
+	w := NewWatcher(client, "board", Options{})
+	if err := w.Run(context.Background(), make(chan *Change)); err == nil {
+		t.Fatalf("Should failed")
+	}
+}
+
+func TestWatcher_next(t *testing.T) {
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	w := NewWatcher(nil, "board", Options{MinInterval: time.Second, MaxInterval: 8 * time.Second})
+
+	tcs := map[string]struct {
+		interval time.Duration
+		changed  bool
+		rate     miro.RateLimit
+		want     time.Duration
+	}{
+		"changed": {
+			interval: 4 * time.Second,
+			changed:  true,
+			want:     time.Second,
+		},
+		"quiet": {
+			interval: 2 * time.Second,
+			want:     4 * time.Second,
+		},
+		"quiet at max": {
+			interval: 8 * time.Second,
+			want:     8 * time.Second,
+		},
+		"plenty of requests": {
+			interval: time.Second,
+			changed:  true,
+			rate:     miro.RateLimit{Limit: 1000, Remaining: 1000, Reset: now.Add(time.Minute)},
+			want:     time.Second,
+		},
+		"few requests": {
+			interval: time.Second,
+			changed:  true,
+			rate:     miro.RateLimit{Limit: 1000, Remaining: 100, Reset: now.Add(time.Minute)},
+			want:     6 * time.Second,
+		},
+		"no request": {
+			interval: time.Second,
+			changed:  true,
+			rate:     miro.RateLimit{Limit: 1000, Remaining: 0, Reset: now.Add(time.Minute)},
+			want:     time.Minute,
+		},
+		"reset passed": {
+			interval: time.Second,
+			changed:  true,
+			rate:     miro.RateLimit{Limit: 1000, Remaining: 0, Reset: now.Add(-time.Minute)},
+			want:     time.Second,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got := w.next(tc.interval, tc.changed, tc.rate, now)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -1,53 +1,521 @@
 package miro
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
 const (
 	widgetsPath = "widgets"
 )
 
 // WidgetsService handles communication to Miro Widgets API.
 //
-// API doc: https://developers.miro.com/reference#board-object
+// API doc: https://developers.miro.com/reference#widget-object
 type WidgetsService service
 
+// WidgetType represents the type of a widget.
+type WidgetType string
+
+const (
+	WidgetTypeSticker WidgetType = "sticker"
+	WidgetTypeShape   WidgetType = "shape"
+	WidgetTypeText    WidgetType = "text"
+	WidgetTypeLine    WidgetType = "line"
+	WidgetTypeCard    WidgetType = "card"
+	WidgetTypeFrame   WidgetType = "frame"
+)
+
+// Widget is implemented by every widget type, such as *Sticker and *Frame.
+// DecodeWidget returns the widget type matching the type member of the JSON.
 type Widget interface {
+	// Base returns the members common to every widget.
+	Base() *WidgetBase
+}
+
+// Point represents a position on a board.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// WidgetBase represents the members common to every widget.
+// X and Y are the position of the center of the widget on the board.
+//
+//go:generate gomodifytags -file $GOFILE -struct WidgetBase -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct WidgetBase -add-tags json -w -transform camelcase
+type WidgetBase struct {
+	ID           string                     `json:"id,omitempty"`
+	Type         WidgetType                 `json:"type"`
+	X            float64                    `json:"x"`
+	Y            float64                    `json:"y"`
+	Width        float64                    `json:"width,omitempty"`
+	Height       float64                    `json:"height,omitempty"`
+	Rotation     float64                    `json:"rotation,omitempty"`
+	Scale        float64                    `json:"scale,omitempty"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+	ModifiedAt   time.Time                  `json:"modifiedAt"`
+	CreatedBy    *MiniUser                  `json:"createdBy,omitempty"`
+	ModifiedBy   *MiniUser                  `json:"modifiedBy,omitempty"`
+	Metadata     map[string]json.RawMessage `json:"metadata,omitempty"`
+	Capabilities *WidgetCapabilities        `json:"capabilities,omitempty"`
+}
+
+// Base returns the widget base itself so that every widget embedding it implements Widget.
+func (b *WidgetBase) Base() *WidgetBase {
+	return b
+}
+
+// Position returns the position of the center of the widget.
+func (b *WidgetBase) Position() Point {
+	return Point{X: b.X, Y: b.Y}
+}
+
+// WidgetCapabilities represents what the current user can do with the widget.
+type WidgetCapabilities struct {
+	Editable bool `json:"editable"`
+}
+
+// WidgetStyle represents the style of a widget.
+// Every widget type uses its own subset of the members.
+//
+//go:generate gomodifytags -file $GOFILE -struct WidgetStyle -clear-tags -w
+//go:generate gomodifytags --file $GOFILE --struct WidgetStyle -add-tags json -w -transform camelcase
+type WidgetStyle struct {
+	BackgroundColor   string  `json:"backgroundColor,omitempty"`
+	BackgroundOpacity float64 `json:"backgroundOpacity,omitempty"`
+	BorderColor       string  `json:"borderColor,omitempty"`
+	BorderOpacity     float64 `json:"borderOpacity,omitempty"`
+	BorderStyle       string  `json:"borderStyle,omitempty"`
+	BorderWidth       float64 `json:"borderWidth,omitempty"`
+	FontFamily        string  `json:"fontFamily,omitempty"`
+	FontSize          float64 `json:"fontSize,omitempty"`
+	ShapeType         string  `json:"shapeType,omitempty"`
+	TextAlign         string  `json:"textAlign,omitempty"`
+	TextAlignVertical string  `json:"textAlignVertical,omitempty"`
+	TextColor         string  `json:"textColor,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Sticker object represents Miro Sticker.
 //
 // API doc: https://developers.miro.com/reference#sticker
+//
 //go:generate gomodifytags -file $GOFILE -struct Sticker -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Sticker -add-tags json -w -transform camelcase
 type Sticker struct {
+	WidgetBase
+	Text  string       `json:"text"`
+	Style *WidgetStyle `json:"style,omitempty"`
+	Tags  []*Tag       `json:"tags,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Shape object represents Miro Shape.
 //
 // API doc: https://developers.miro.com/reference#shape
+//
 //go:generate gomodifytags -file $GOFILE -struct Shape -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Shape -add-tags json -w -transform camelcase
 type Shape struct {
+	WidgetBase
+	Text  string       `json:"text"`
+	Style *WidgetStyle `json:"style,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Text object represents Miro Text.
 //
 // API doc: https://developers.miro.com/reference#text
+//
 //go:generate gomodifytags -file $GOFILE -struct Text -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Text -add-tags json -w -transform camelcase
 type Text struct {
-}
+	WidgetBase
+	Text  string       `json:"text"`
+	Style *WidgetStyle `json:"style,omitempty"`
 
-// Line object represents Miro Line.
-//
-// API doc: https://developers.miro.com/reference#line
-//go:generate gomodifytags -file $GOFILE -struct Line -clear-tags -w
-//go:generate gomodifytags --file $GOFILE --struct Line -add-tags json -w -transform camelcase
-type Line struct {
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Card object represents Miro Card.
 //
 // API doc: https://developers.miro.com/reference#card
+//
 //go:generate gomodifytags -file $GOFILE -struct Card -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Card -add-tags json -w -transform camelcase
 type Card struct {
+	WidgetBase
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Style       *WidgetStyle  `json:"style,omitempty"`
+	Tags        []*Tag        `json:"tags,omitempty"`
+	Assignee    *CardAssignee `json:"assignee,omitempty"`
+	DueDate     *time.Time    `json:"dueDate,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CardAssignee represents the user a card is assigned to.
+type CardAssignee struct {
+	UserID string `json:"userId"`
+}
+
+// UnknownWidget represents a widget of a type unknown to this package.
+// The members other than the common ones are kept in Extra.
+type UnknownWidget struct {
+	WidgetBase
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// listWidgetsResponse represents list widgets response from Miro.
+type listWidgetsResponse struct {
+	Size int               `json:"size"`
+	Data []json.RawMessage `json:"data"`
+}
+
+// moveWidgetRequest represents move widget request payload.
+type moveWidgetRequest struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// readOnlyWidgetMembers is the list of widget members Miro does not accept in create and update requests.
+// Tags are attached to widgets with the tags API instead.
+var readOnlyWidgetMembers = []string{"id", "createdAt", "modifiedAt", "createdBy", "modifiedBy", "capabilities", "tags"}
+
+// DecodeWidget decodes a widget into the widget type matching its type member.
+// Widgets of types unknown to this package are decoded into *UnknownWidget.
+func DecodeWidget(data []byte) (Widget, error) {
+	head := struct {
+		Type WidgetType `json:"type"`
+	}{}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+
+	var w Widget
+	switch head.Type {
+	case WidgetTypeSticker:
+		w = &Sticker{}
+	case WidgetTypeShape:
+		w = &Shape{}
+	case WidgetTypeText:
+		w = &Text{}
+	case WidgetTypeLine:
+		w = &Line{}
+	case WidgetTypeCard:
+		w = &Card{}
+	case WidgetTypeFrame:
+		w = &Frame{}
+	default:
+		w = &UnknownWidget{}
+	}
+
+	if err := json.Unmarshal(data, w); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// List lists the widgets on the board by Board ID.
+// An empty widget type lists the widgets of every type.
+//
+// API doc: https://developers.miro.com/reference#get-board-widgets
+func (s *WidgetsService) List(ctx context.Context, boardID string, widgetType WidgetType) ([]Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.List")
+
+	path := addQuery(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, widgetsPath), url.Values{"widgetType": {string(widgetType)}})
+	req, err := s.client.NewGetRequest(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list, resp, err := do[listWidgetsResponse](ctx, s.client, req, http.StatusOK)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	widgets := make([]Widget, 0, len(list.Data))
+	for _, data := range list.Data {
+		w, err := DecodeWidget(data)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		widgets = append(widgets, w)
+	}
+
+	return widgets, resp, nil
+}
+
+// Get gets the widget on the board by Board ID and Widget ID.
+//
+// API doc: https://developers.miro.com/reference#get-widget
+func (s *WidgetsService) Get(ctx context.Context, boardID, widgetID string) (Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Get")
+
+	req, err := s.client.NewGetRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, widgetsPath, widgetID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doWidget(ctx, s.client, req, http.StatusOK)
+}
+
+// Create creates the widget on the board.
+// The members of the widget set by Miro, such as ID and CreatedAt, are not sent.
+//
+// API doc: https://developers.miro.com/reference#create-board-widgets
+func (s *WidgetsService) Create(ctx context.Context, boardID string, w Widget) (Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Create")
+
+	body, err := widgetPayload(w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewPostRequest(fmt.Sprintf("%s/%s/%s", boardsPath, boardID, widgetsPath), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doWidget(ctx, s.client, req, http.StatusOK, http.StatusCreated)
+}
+
+// Update updates the widget on the board by Board ID and Widget ID with every member of w.
+// Get the widget and change it before updating it to keep the other members.
+//
+// API doc: https://developers.miro.com/reference#update-board-widget
+func (s *WidgetsService) Update(ctx context.Context, boardID, widgetID string, w Widget) (Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Update")
+
+	body, err := widgetPayload(w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.patch(ctx, boardID, widgetID, body)
+}
+
+// Move moves the center of the widget on the board to p.
+func (s *WidgetsService) Move(ctx context.Context, boardID, widgetID string, p Point) (Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Move")
+
+	return s.patch(ctx, boardID, widgetID, &moveWidgetRequest{X: p.X, Y: p.Y})
+}
+
+// MoveMany moves the center of the widgets on the board to their positions by Widget ID,
+// such as the positions computed by the layout package.
+// The widgets are moved one by one in the order of their IDs and it stops at the first error.
+func (s *WidgetsService) MoveMany(ctx context.Context, boardID string, positions map[string]Point) ([]Widget, *Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.MoveMany")
+
+	ids := make([]string, 0, len(positions))
+	for id := range positions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var resp *Response
+	widgets := make([]Widget, 0, len(ids))
+	for _, id := range ids {
+		w, r, err := s.Move(ctx, boardID, id, positions[id])
+		if err != nil {
+			return widgets, r, err
+		}
+
+		widgets = append(widgets, w)
+		resp = r
+	}
+
+	return widgets, resp, nil
+}
+
+// Delete deletes the widget on the board by Board ID and Widget ID.
+//
+// API doc: https://developers.miro.com/reference#delete-board-widget
+func (s *WidgetsService) Delete(ctx context.Context, boardID, widgetID string) (*Response, error) {
+	ctx = withMethod(ctx, "WidgetsService.Delete")
+
+	req, err := s.client.NewDeleteRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, widgetsPath, widgetID))
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := do[struct{}](ctx, s.client, req, http.StatusNoContent)
+	return resp, err
+}
+
+func (s *WidgetsService) patch(ctx context.Context, boardID, widgetID string, body interface{}) (Widget, *Response, error) {
+	req, err := s.client.NewPatchRequest(fmt.Sprintf("%s/%s/%s/%s", boardsPath, boardID, widgetsPath, widgetID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doWidget(ctx, s.client, req, http.StatusOK)
+}
+
+// doWidget sends the request and decodes the widget in the response.
+func doWidget(ctx context.Context, c *Client, req *http.Request, expected ...int) (Widget, *Response, error) {
+	raw, resp, err := do[json.RawMessage](ctx, c, req, expected...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	w, err := DecodeWidget(*raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, nil
+}
+
+// widgetPayload encodes the widget without the members Miro does not accept in requests.
+func widgetPayload(w Widget) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, err
+	}
+
+	for _, k := range readOnlyWidgetMembers {
+		delete(payload, k)
+	}
+
+	return payload, nil
+}
+
+// UnmarshalJSON decodes the widget style keeping the members unknown to this package in Extra.
+func (s *WidgetStyle) UnmarshalJSON(data []byte) error {
+	type alias WidgetStyle
+	extra, err := unmarshalExtra(data, (*alias)(s))
+	if err != nil {
+		return err
+	}
+
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the widget style with the members in Extra.
+func (s WidgetStyle) MarshalJSON() ([]byte, error) {
+	type alias WidgetStyle
+	return marshalExtra(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the sticker keeping the members unknown to this package in Extra.
+func (s *Sticker) UnmarshalJSON(data []byte) error {
+	type alias Sticker
+	extra, err := unmarshalExtra(data, (*alias)(s))
+	if err != nil {
+		return err
+	}
+
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the sticker with the members in Extra.
+func (s Sticker) MarshalJSON() ([]byte, error) {
+	type alias Sticker
+	if s.Type == "" {
+		s.Type = WidgetTypeSticker
+	}
+	return marshalExtra(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the shape keeping the members unknown to this package in Extra.
+func (s *Shape) UnmarshalJSON(data []byte) error {
+	type alias Shape
+	extra, err := unmarshalExtra(data, (*alias)(s))
+	if err != nil {
+		return err
+	}
+
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the shape with the members in Extra.
+func (s Shape) MarshalJSON() ([]byte, error) {
+	type alias Shape
+	if s.Type == "" {
+		s.Type = WidgetTypeShape
+	}
+	return marshalExtra(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes the text keeping the members unknown to this package in Extra.
+func (t *Text) UnmarshalJSON(data []byte) error {
+	type alias Text
+	extra, err := unmarshalExtra(data, (*alias)(t))
+	if err != nil {
+		return err
+	}
+
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the text with the members in Extra.
+func (t Text) MarshalJSON() ([]byte, error) {
+	type alias Text
+	if t.Type == "" {
+		t.Type = WidgetTypeText
+	}
+	return marshalExtra(alias(t), t.Extra)
+}
+
+// UnmarshalJSON decodes the card keeping the members unknown to this package in Extra.
+func (c *Card) UnmarshalJSON(data []byte) error {
+	type alias Card
+	extra, err := unmarshalExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+
+	c.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the card with the members in Extra.
+func (c Card) MarshalJSON() ([]byte, error) {
+	type alias Card
+	if c.Type == "" {
+		c.Type = WidgetTypeCard
+	}
+	return marshalExtra(alias(c), c.Extra)
+}
+
+// UnmarshalJSON decodes the unknown widget keeping the members unknown to this package in Extra.
+func (w *UnknownWidget) UnmarshalJSON(data []byte) error {
+	type alias UnknownWidget
+	extra, err := unmarshalExtra(data, (*alias)(w))
+	if err != nil {
+		return err
+	}
+
+	w.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes the unknown widget with the members in Extra.
+func (w UnknownWidget) MarshalJSON() ([]byte, error) {
+	type alias UnknownWidget
+	return marshalExtra(alias(w), w.Extra)
 }
@@ -0,0 +1,270 @@
+package miro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func getStickerJSON(id string) string {
+	return fmt.Sprintf(`{
+	"id": "%s",
+	"type": "sticker",
+	"x": 10,
+	"y": 20,
+	"width": 199,
+	"height": 228,
+	"scale": 1,
+	"text": "hello",
+	"style": {"backgroundColor": "#fff9b1", "fontSize": 14},
+	"capabilities": {"editable": true},
+	"createdAt": "1995-06-15T10:00:00Z",
+	"modifiedAt": "1995-06-15T10:00:00Z"
+}`, id)
+}
+
+func getSticker(id string) *Sticker {
+	at, _ := time.Parse(time.RFC3339, "1995-06-15T10:00:00Z")
+
+	return &Sticker{
+		WidgetBase: WidgetBase{
+			ID:           id,
+			Type:         WidgetTypeSticker,
+			X:            10,
+			Y:            20,
+			Width:        199,
+			Height:       228,
+			Scale:        1,
+			Capabilities: &WidgetCapabilities{Editable: true},
+			CreatedAt:    at,
+			ModifiedAt:   at,
+		},
+		Text:  "hello",
+		Style: &WidgetStyle{BackgroundColor: "#fff9b1", FontSize: 14},
+	}
+}
+
+func TestDecodeWidget(t *testing.T) {
+	tcs := map[string]struct {
+		in   string
+		want Widget
+	}{
+		"sticker": {getStickerJSON("1"), getSticker("1")},
+		"frame":   {getFrameJSON("2", 0, 0, 100, 100, "1"), getFrame("2", 0, 0, 100, 100, "1")},
+		"unknown": {`{"id": "3", "type": "embed", "html": "<p>"}`, &UnknownWidget{
+			WidgetBase: WidgetBase{ID: "3", Type: "embed"},
+			Extra:      extra("html", `"<p>"`),
+		}},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := DecodeWidget([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		widgetType WidgetType
+		wantQuery  string
+		want       []Widget
+	}{
+		"all":     {"", "", []Widget{getSticker("1"), getFrame("2", 0, 0, 100, 100, "1")}},
+		"sticker": {WidgetTypeSticker, "widgetType=sticker", []Widget{getSticker("1")}},
+	}
+
+	query := ""
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		if r.URL.Query().Get("widgetType") == string(WidgetTypeSticker) {
+			fmt.Fprintf(w, `{"type": "collection", "size": 1, "data": [%s]}`, getStickerJSON("1"))
+			return
+		}
+		fmt.Fprintf(w, `{"type": "collection", "size": 2, "data": [%s, %s]}`, getStickerJSON("1"), getFrameJSON("2", 0, 0, 100, 100, "1"))
+	})
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, _, err := client.Widgets.List(context.Background(), "board", tc.widgetType)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if query != tc.wantQuery {
+				t.Fatalf("query not expected, got:%s", query)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		in   Widget
+		want string
+	}{
+		"sticker": {
+			&Sticker{WidgetBase: WidgetBase{ID: "ignored", X: 1, Y: 2}, Text: "hello"},
+			`{"text":"hello","type":"sticker","x":1,"y":2}`,
+		},
+		"frame": {
+			&Frame{WidgetBase: WidgetBase{Width: 100, Height: 50}, Title: "exercise", Children: []string{}},
+			`{"children":[],"height":50,"title":"exercise","type":"frame","width":100,"x":0,"y":0}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/%s/%s", boardsPath, n, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+				got := map[string]json.RawMessage{}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				b, _ := json.Marshal(got)
+				if diff := cmp.Diff(string(b), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, getStickerJSON("1"))
+			})
+
+			got, _, err := client.Widgets.Create(context.Background(), n, tc.in)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, Widget(getSticker("1"))); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_Move(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id   string
+		p    Point
+		want string
+	}{
+		"ok": {"1", Point{X: -5, Y: 7.5}, `{"x":-5,"y":7.5}`},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, widgetsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+
+				var got json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(string(got), tc.want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				fmt.Fprint(w, getStickerJSON(tc.id))
+			})
+
+			if _, _, err := client.Widgets.Move(context.Background(), "board", tc.id, tc.p); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestWidgetsService_MoveMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	patches := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/board/%s/", boardsPath, widgetsPath), func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		var got json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		patches = append(patches, id+" "+string(got))
+
+		if id == "3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, getStickerJSON(id))
+	})
+
+	got, _, err := client.Widgets.MoveMany(context.Background(), "board", map[string]Point{
+		"2": {X: 3, Y: 4},
+		"1": {X: 1, Y: 2},
+		"3": {X: 5, Y: 6},
+		"4": {X: 7, Y: 8},
+	})
+	if err == nil {
+		t.Fatalf("Should failed")
+	}
+
+	if diff := cmp.Diff(got, []Widget{getSticker("1"), getSticker("2")}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	want := []string{`1 {"x":1,"y":2}`, `2 {"x":3,"y":4}`, `3 {"x":5,"y":6}`}
+	if diff := cmp.Diff(patches, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWidgetsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		id string
+	}{
+		"ok": {"1"},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			mux.HandleFunc(fmt.Sprintf("/%s/board/%s/%s", boardsPath, widgetsPath, tc.id), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Fatalf("method not expected, got:%s", r.Method)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			if _, err := client.Widgets.Delete(context.Background(), "board", tc.id); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		})
+	}
+}